@@ -4,15 +4,36 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
 	"golang.org/x/net/context"
 
+	"github.com/kyprizel/ct_mon/pkg/db"
 	"github.com/kyprizel/ct_mon/pkg/mon"
 	"github.com/kyprizel/ct_mon/utils"
 )
 
 func Run() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "lint":
+			runLint(os.Args[2:])
+			return
+		case "precerts":
+			runPrecerts(os.Args[2:])
+			return
+		case "suppress":
+			runSuppress(os.Args[2:])
+			return
+		case "export":
+			runExport(os.Args[2:])
+			return
+		case "triage":
+			runTriage(os.Args[2:])
+			return
+		}
+	}
 
 	var configFile = flag.String("config", "conf/config.json", "Config file path.")
 	var foreground = flag.Bool("foreground", true, "Stay in foreground.")
@@ -40,7 +61,8 @@ func Run() {
 		cancel()
 		select {
 		case err = <-promise:
-		case <-time.After(time.Second * 5):
+		/* sinks may need to send digests */
+		case <-time.After(time.Second * 60):
 			err = fmt.Errorf("timeout exceeded")
 		}
 	case err = <-promise:
@@ -49,3 +71,23 @@ func Run() {
 		log.Fatal(err)
 	}
 }
+
+/* DB connection for subcommands working with stored data */
+func openDB(configFile string) db.Store {
+	conf, err := mon.ReadConfig(configFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	dbType, uri := conf.DBType, conf.DBURI
+	if uri == "" {
+		dbType, uri = "mongo", conf.MongoURI
+	}
+	if uri == "" {
+		log.Fatal("No database configured")
+	}
+	mdb, err := db.Open(dbType, uri)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return mdb
+}
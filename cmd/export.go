@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"encoding/pem"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/kyprizel/ct_mon/pkg/certs"
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+/* export stored certificate or precertificate with its issuer chain as PEM bundle */
+func runExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	var configFile = fs.String("config", "conf/config.json", "Config file path.")
+	var fingerprint = fs.String("fingerprint", "", "Fingerprint of stored certificate.")
+	var index = fs.Int64("index", -1, "Log index of stored certificate.")
+	var logUri = fs.String("log", "", "Log URI of -index, any log if empty.")
+	var out = fs.String("out", "-", "Output file, - for stdout.")
+	fs.Parse(args)
+
+	store := openDB(*configFile)
+	defer store.Close()
+
+	var cert *db.CertInfo
+	var err error
+	switch {
+	case *fingerprint != "":
+		cert, err = store.FindCert(*fingerprint)
+	case *index >= 0:
+		cert, err = store.FindCertByIndex(*logUri, *index)
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("Can't load certificate (%v)", err)
+	}
+
+	block, _ := pem.Decode([]byte(cert.PEMCert))
+	if block == nil {
+		log.Fatal("No certificate stored")
+	}
+	if len(cert.Chain) == 0 {
+		log.Print("No issuer chain stored, exporting leaf only")
+	}
+	bundle := certs.PEMBundle(block.Bytes, cert.Chain)
+
+	if *out == "-" {
+		os.Stdout.Write(bundle)
+		return
+	}
+	if err := ioutil.WriteFile(*out, bundle, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
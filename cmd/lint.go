@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/google/certificate-transparency/go/x509"
+
+	"github.com/kyprizel/ct_mon/pkg/lint"
+)
+
+/* precertificates are stored as bare TBSCertificate, non-fatal parse errors are left to lints */
+func parseCertOrTBS(der []byte) (*x509.Certificate, error) {
+	c, err := x509.ParseCertificate(der)
+	if _, ok := err.(x509.NonFatalErrors); err == nil || ok {
+		return c, nil
+	}
+	c, err = x509.ParseTBSCertificate(der)
+	if _, ok := err.(x509.NonFatalErrors); ok {
+		return c, nil
+	}
+	return c, err
+}
+
+func lintPEM(data []byte) ([]lint.Result, error) {
+	var res []lint.Result
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := parseCertOrTBS(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, lint.Check(c)...)
+	}
+	return res, nil
+}
+
+func runLint(args []string) {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	var configFile = fs.String("config", "conf/config.json", "Config file path.")
+	var pemFile = fs.String("pem", "", "PEM file to lint.")
+	var index = fs.Int64("index", -1, "Log index of stored certificate to lint.")
+	var logUri = fs.String("log", "", "Log URI of -index, any log if empty.")
+	var fingerprint = fs.String("fingerprint", "", "Fingerprint of stored certificate to lint.")
+	fs.Parse(args)
+
+	var data []byte
+	var err error
+	switch {
+	case *pemFile != "":
+		data, err = ioutil.ReadFile(*pemFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	case *fingerprint != "":
+		cert, err := openDB(*configFile).FindCert(*fingerprint)
+		if err != nil {
+			log.Fatalf("Can't load certificate %s (%v)", *fingerprint, err)
+		}
+		data = []byte(cert.PEMCert)
+	case *index >= 0:
+		cert, err := openDB(*configFile).FindCertByIndex(*logUri, *index)
+		if err != nil {
+			log.Fatalf("Can't load certificate %d (%v)", *index, err)
+		}
+		data = []byte(cert.PEMCert)
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	res, err := lintPEM(data)
+	if err != nil {
+		log.Fatalf("Can't parse certificate (%v)", err)
+	}
+	for _, r := range res {
+		fmt.Println(r)
+	}
+	if lint.HasErrors(res) {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+/* report precerts never followed by a final certificate */
+func runPrecerts(args []string) {
+	fs := flag.NewFlagSet("precerts", flag.ExitOnError)
+	var configFile = fs.String("config", "conf/config.json", "Config file path.")
+	var age = fs.Duration("age", 24*time.Hour, "Report precerts seen longer than this ago.")
+	fs.Parse(args)
+
+	precerts, err := openDB(*configFile).UnlinkedPrecerts(time.Now().UTC().Add(-*age))
+	if err != nil {
+		log.Fatalf("Can't load precerts (%v)", err)
+	}
+	for _, p := range precerts {
+		fmt.Printf("%s\t%d\t%s\t%s\t%s\t%s\n", p.Log, p.Index, p.Created.Format(time.RFC3339),
+			p.CommonName, strings.Join(p.DNSNames, ","), p.TBSHash)
+	}
+}
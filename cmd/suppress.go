@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+/* manage suppressions: matched certificates are stored but not notified */
+func runSuppress(args []string) {
+	fs := flag.NewFlagSet("suppress", flag.ExitOnError)
+	var configFile = fs.String("config", "conf/config.json", "Config file path.")
+	var name = fs.String("name", "", "DNS name to suppress, *.example.com suppresses all subdomains.")
+	var fingerprint = fs.String("fingerprint", "", "Certificate fingerprint to suppress.")
+	var reason = fs.String("reason", "", "Why the match is legitimate.")
+	var list = fs.Bool("list", false, "List suppressions.")
+	var remove = fs.String("remove", "", "Remove suppression by id.")
+	fs.Parse(args)
+
+	store := openDB(*configFile)
+	defer store.Close()
+
+	switch {
+	case *list:
+		suppressions, err := store.Suppressions()
+		if err != nil {
+			log.Fatalf("Can't load suppressions (%v)", err)
+		}
+		for _, s := range suppressions {
+			fmt.Printf("%s\t%s\t%s\t%s\t%s\n", s.Id.Hex(), s.Created.Format(time.RFC3339),
+				s.Fingerprint, s.Name, s.Reason)
+		}
+	case *remove != "":
+		if err := store.RemoveSuppression(*remove); err != nil {
+			log.Fatalf("Can't remove suppression %s (%v)", *remove, err)
+		}
+	case *name != "" || *fingerprint != "":
+		s := &db.Suppression{Name: *name, Fingerprint: *fingerprint, Reason: *reason}
+		if err := store.AddSuppression(s); err != nil {
+			log.Fatalf("Can't add suppression (%v)", err)
+		}
+		fmt.Println(s.Id.Hex())
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+}
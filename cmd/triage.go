@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/api"
+	"github.com/kyprizel/ct_mon/pkg/mon"
+)
+
+/* change triage state of stored match through API of running monitor, so sinks are notified */
+func runTriage(args []string) {
+	fs := flag.NewFlagSet("triage", flag.ExitOnError)
+	var configFile = fs.String("config", "conf/config.json", "Config file path, api_listen and api_token are used.")
+	var apiURL = fs.String("api", "", "API base URL, http://<api_listen> by default.")
+	var fingerprint = fs.String("fingerprint", "", "Fingerprint of stored match.")
+	var status = fs.String("status", "", "New status: new, acknowledged, legitimate, malicious or revoked-requested.")
+	var assignee = fs.String("assignee", "", "Assign to analyst, - to unassign.")
+	var note = fs.String("note", "", "Note added to history.")
+	var by = fs.String("by", os.Getenv("USER"), "Analyst name recorded in history.")
+	fs.Parse(args)
+
+	if *fingerprint == "" {
+		fs.Usage()
+		os.Exit(2)
+	}
+	conf, err := mon.ReadConfig(*configFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	base := *apiURL
+	if base == "" {
+		if conf.APIListen == "" {
+			log.Fatal("No api_listen configured, set -api")
+		}
+		base = "http://" + conf.APIListen
+	}
+	url := strings.TrimRight(base, "/") + api.Prefix + "matches/" + *fingerprint
+
+	var req *http.Request
+	if *status == "" && *assignee == "" && *note == "" {
+		/* nothing to change, show current state */
+		req, err = http.NewRequest("GET", url, nil)
+	} else {
+		t := &api.TriageRequest{Note: *note, By: *by}
+		if *status != "" {
+			t.Status = status
+		}
+		if *assignee == "-" {
+			*assignee = ""
+			t.Assignee = assignee
+		} else if *assignee != "" {
+			t.Assignee = assignee
+		}
+		body, _ := json.Marshal(t)
+		req, err = http.NewRequest("POST", url+"/triage", bytes.NewReader(body))
+		if err == nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if conf.APIToken != "" {
+		req.Header.Set("Authorization", "Bearer "+conf.APIToken)
+	}
+
+	resp, err := (&http.Client{Timeout: 30 * time.Second}).Do(req)
+	if err != nil {
+		log.Fatalf("API request failed (%v)", err)
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("API request failed (%s: %s)", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var m api.Match
+	if err := json.Unmarshal(body, &m); err != nil {
+		log.Fatalf("Invalid API response (%v)", err)
+	}
+	fmt.Printf("%s\t%s\t%s\t%s\n", m.Fingerprint, m.CommonName, m.Status, m.Assignee)
+	for _, c := range m.History {
+		if c.Field == models.TriageFieldNote {
+			fmt.Printf("%s\t%s\tnote\t%s\n", c.Time.Format(time.RFC3339), c.By, c.To)
+		} else {
+			fmt.Printf("%s\t%s\t%s\t%s -> %s\n", c.Time.Format(time.RFC3339), c.By, c.Field, c.From, c.To)
+		}
+	}
+}
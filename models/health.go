@@ -0,0 +1,36 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Log health problems.
+const (
+	/* latest STH is older than log MMD */
+	LogSTHStale = "sth_stale"
+	/* tree size has not grown for too long */
+	LogNotGrowing = "not_growing"
+	/* STH or entries can't be fetched for too long */
+	LogFetchFailing = "fetch_failing"
+)
+
+// LogHealth reports CT log problem found, or gone if |Resolved| is set.
+type LogHealth struct {
+	Log      string    `json:"log"`
+	LogID    string    `json:"log_id,omitempty"`
+	Problem  string    `json:"problem"`
+	Resolved bool      `json:"resolved"`
+	Severity Severity  `json:"severity"`
+	Message  string    `json:"message"`
+	Since    time.Time `json:"since"`
+	Time     time.Time `json:"time"`
+}
+
+// Title returns one line description of |h|.
+func (h *LogHealth) Title() string {
+	if h.Resolved {
+		return fmt.Sprintf("CT log %s recovered: %s", h.Log, h.Problem)
+	}
+	return fmt.Sprintf("CT log %s problem: %s", h.Log, h.Problem)
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/certificate-transparency/go"
+)
+
+type Severity string
+
+const (
+	SeverityLow      Severity = "low"
+	SeverityMedium   Severity = "medium"
+	SeverityHigh     Severity = "high"
+	SeverityCritical Severity = "critical"
+)
+
+var severityLevels = map[Severity]int{
+	SeverityLow:      1,
+	SeverityMedium:   2,
+	SeverityHigh:     3,
+	SeverityCritical: 4,
+}
+
+// Level returns order of |s| for comparison, 0 for empty or unknown severity.
+func (s Severity) Level() int {
+	return severityLevels[s]
+}
+
+// ParseSeverity validates severity name |s|, empty means medium.
+func ParseSeverity(s string) (Severity, error) {
+	if s == "" {
+		return SeverityMedium, nil
+	}
+	if Severity(s).Level() == 0 {
+		return "", fmt.Errorf("unknown severity %q", s)
+	}
+	return Severity(s), nil
+}
+
+// Match is normalised record of matched log entry, the same for every sink.
+type Match struct {
+	Log             string    `json:"log"`
+	LogID           string    `json:"log_id,omitempty"`
+	Index           int64     `json:"index"`
+	Timestamp       time.Time `json:"timestamp"`
+	Fingerprint     string    `json:"fingerprint"`
+	SHA256          string    `json:"sha256"`
+	TBSHash         string    `json:"tbs_hash,omitempty"`
+	Precert         bool      `json:"precert"`
+	FinalForPrecert bool      `json:"final_for_precert,omitempty"`
+	Suppressed      bool      `json:"suppressed,omitempty"`
+	Escalated       bool      `json:"escalated,omitempty"`
+	Rules           []string  `json:"rules"`
+	Severity        Severity  `json:"severity"`
+	CommonName      string    `json:"cn"`
+	DNSNames        []string  `json:"dns_names"`
+	Issuer          string    `json:"issuer"`
+	Serial          string    `json:"serial"`
+	NotBefore       time.Time `json:"not_before"`
+	NotAfter        time.Time `json:"not_after"`
+	PEM             string    `json:"pem"`
+	/* raw entry for sinks storing full certificate details */
+	Entry *ct.LogEntry `json:"-"`
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+type TriageStatus string
+
+const (
+	TriageNew             TriageStatus = "new"
+	TriageAcknowledged    TriageStatus = "acknowledged"
+	TriageLegitimate      TriageStatus = "legitimate"
+	TriageMalicious       TriageStatus = "malicious"
+	TriageRevokeRequested TriageStatus = "revoked-requested"
+)
+
+var triageStatuses = map[TriageStatus]bool{
+	TriageNew:             true,
+	TriageAcknowledged:    true,
+	TriageLegitimate:      true,
+	TriageMalicious:       true,
+	TriageRevokeRequested: true,
+}
+
+// ParseTriageStatus validates status name |s|.
+func ParseTriageStatus(s string) (TriageStatus, error) {
+	if !triageStatuses[TriageStatus(s)] {
+		return "", fmt.Errorf("unknown status %q", s)
+	}
+	return TriageStatus(s), nil
+}
+
+// Triage change fields.
+const (
+	TriageFieldStatus   = "status"
+	TriageFieldAssignee = "assignee"
+	TriageFieldNote     = "note"
+)
+
+// TriageChange is audit record of one field change by analyst |By|, notes have |To| only.
+type TriageChange struct {
+	Time  time.Time `json:"time" bson:"time"`
+	By    string    `json:"by" bson:"by"`
+	Field string    `json:"field" bson:"field"`
+	From  string    `json:"from,omitempty" bson:"from,omitempty"`
+	To    string    `json:"to" bson:"to"`
+}
+
+// TriageEvent reports status change of stored match to sinks.
+type TriageEvent struct {
+	Match    *Match         `json:"match"`
+	Status   TriageStatus   `json:"status"`
+	Assignee string         `json:"assignee,omitempty"`
+	By       string         `json:"by"`
+	Changes  []TriageChange `json:"changes"`
+	Time     time.Time      `json:"time"`
+}
+
+// Title returns one line description of |t|.
+func (t *TriageEvent) Title() string {
+	return fmt.Sprintf("Match %s is %s by %s", t.Match.CommonName, t.Status, t.By)
+}
+
+// Note returns note added with the change, empty if none.
+func (t *TriageEvent) Note() string {
+	for _, c := range t.Changes {
+		if c.Field == TriageFieldNote {
+			return c.To
+		}
+	}
+	return ""
+}
@@ -10,9 +10,36 @@ const (
 	CT_QUIT CTLogEntryType = iota
 	CT_CERT
 	CT_PRECERT
+	CT_LOG_HEALTH
+	CT_ESCALATION
+	CT_TRIAGE
 )
 
 type MonEvent struct {
 	Type     CTLogEntryType
 	LogEntry *ct.LogEntry
+	/* log URI the entry was first seen in */
+	Log string
+	/* base64 SHA256 of log public key, empty if key is not configured */
+	LogID string
+	/* SHA256 of leaf, of TBSCertificate for precerts */
+	Fingerprint string
+	/* TBS hash without CT extensions, same for precert and its final cert */
+	TBSHash string
+	/* certificate was issued for previously reported precert */
+	FinalForPrecert bool
+	/* matches a suppression, stored but not notified */
+	Suppressed bool
+	/* names of rules the entry matched */
+	Rules []string
+	/* highest severity of matched rules */
+	Severity Severity
+	/* set for CT_LOG_HEALTH */
+	Health *LogHealth
+	/* set for CT_ESCALATION, built from stored match */
+	Match *Match
+	/* set for CT_TRIAGE */
+	Triage *TriageEvent
+	/* outbox notification ids of the match by sink name */
+	Outbox map[string]string
 }
@@ -0,0 +1,337 @@
+package api
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/certs"
+	"github.com/kyprizel/ct_mon/pkg/db"
+	"github.com/kyprizel/ct_mon/pkg/lint"
+)
+
+const (
+	Prefix       = "/api/v1/"
+	defaultLimit = 50
+	maxLimit     = 500
+)
+
+// Match is stored match returned by API, the same record sinks get with storage fields added.
+type Match struct {
+	models.Match
+	Created  time.Time             `json:"created"`
+	Lints    []lint.Result         `json:"lints,omitempty"`
+	Status   models.TriageStatus   `json:"status"`
+	Assignee string                `json:"assignee,omitempty"`
+	History  []models.TriageChange `json:"history,omitempty"`
+}
+
+// NewMatch converts stored certificate |c| to API record.
+func NewMatch(c *db.CertInfo) *Match {
+	m := &Match{Match: models.Match{Log: c.Log, LogID: c.LogID, Index: c.Index, Timestamp: c.Timestamp,
+		Fingerprint: c.Fingerprint, TBSHash: c.TBSHash, Precert: c.Precert, FinalForPrecert: c.PrecertSeen,
+		Suppressed: c.Suppressed, Rules: c.Rules, Severity: models.Severity(c.Severity),
+		CommonName: c.CommonName, DNSNames: c.DNSNames, Issuer: c.Issuer, Serial: c.Serial,
+		NotBefore: c.NotBefore, NotAfter: c.NotAfter, PEM: c.PEMCert},
+		Created: c.Created, Lints: c.Lints, Status: c.Status, Assignee: c.Assignee, History: c.History}
+	if m.Status == "" {
+		m.Status = models.TriageNew
+	}
+	if block, _ := pem.Decode([]byte(c.PEMCert)); block != nil {
+		sum := sha256.Sum256(block.Bytes)
+		m.SHA256 = hex.EncodeToString(sum[:])
+	}
+	return m
+}
+
+// MatchList is a page of matches, |NextOffset| is set if there may be more.
+type MatchList struct {
+	Matches    []*Match `json:"matches"`
+	Offset     int      `json:"offset"`
+	Limit      int      `json:"limit"`
+	NextOffset *int     `json:"next_offset,omitempty"`
+}
+
+// TriageFunc changes triage state of stored match |fingerprint|, returns updated match.
+type TriageFunc func(fingerprint string, u *db.TriageUpdate) (*db.CertInfo, error)
+
+// TriageRequest is body of triage change request, omitted fields are not changed.
+type TriageRequest struct {
+	Status   *string `json:"status"`
+	Assignee *string `json:"assignee"`
+	Note     string  `json:"note"`
+	/* analyst name recorded in history */
+	By string `json:"by"`
+}
+
+// Server serves JSON API over matches in |Store| under Prefix, triage changes go through |Triage|.
+type Server struct {
+	Store db.Store
+	/* bearer token required if set, triage changes are refused without it */
+	Token  string
+	Triage TriageFunc
+}
+
+func New(store db.Store, token string, triage TriageFunc) *Server {
+	return &Server{Store: store, Token: token, Triage: triage}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="ct_mon"`)
+		writeError(w, http.StatusUnauthorized, "invalid token")
+		return
+	}
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, Prefix), "/")
+	parts := strings.Split(path, "/")
+	if len(parts) == 3 && parts[0] == "matches" && parts[2] == "triage" {
+		if r.Method != "POST" {
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		if s.Token == "" {
+			writeError(w, http.StatusForbidden, "API is read-only without api_token")
+			return
+		}
+		s.triage(w, r, parts[1])
+		return
+	}
+	if r.Method != "GET" && r.Method != "HEAD" {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	switch {
+	case path == "matches":
+		s.listMatches(w, r)
+	case len(parts) == 2 && parts[0] == "matches":
+		s.getMatch(w, parts[1])
+	case len(parts) == 3 && parts[0] == "matches" && (parts[2] == "pem" || parts[2] == "chain"):
+		s.getPEM(w, parts[1], parts[2] == "chain")
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if s.Token == "" {
+		return true
+	}
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.Token)) == 1
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Error writing API response (%v)", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+func parseTime(v string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", v); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, v)
+}
+
+// ParseQuery builds storage query from API parameters |v|.
+func ParseQuery(v map[string][]string) (db.CertQuery, error) {
+	get := func(k string) string {
+		if len(v[k]) == 0 {
+			return ""
+		}
+		return v[k][0]
+	}
+	q := db.CertQuery{Desc: true, Limit: defaultLimit,
+		Issuer: get("issuer"), Fingerprint: get("fingerprint"), Rule: get("rule"), Assignee: get("assignee")}
+	if get("status") != "" {
+		status, err := models.ParseTriageStatus(get("status"))
+		if err != nil {
+			return q, err
+		}
+		q.Status = status
+	}
+
+	/* "*.example.com" is any name under example.com */
+	name := strings.ToLower(get("name"))
+	if strings.HasPrefix(name, "*.") {
+		q.NameSuffix = name[1:]
+	} else {
+		q.Name = name
+	}
+	if suffix := get("suffix"); suffix != "" {
+		q.Name, q.NameSuffix = "", strings.ToLower(suffix)
+	}
+
+	for k, p := range map[string]**bool{"precert": &q.Precert, "suppressed": &q.Suppressed} {
+		if get(k) == "" {
+			continue
+		}
+		b, err := strconv.ParseBool(get(k))
+		if err != nil {
+			return q, fmt.Errorf("invalid %s", k)
+		}
+		*p = &b
+	}
+
+	for k, p := range map[string]*time.Time{"created_after": &q.CreatedAfter, "created_before": &q.CreatedBefore,
+		"not_after_after": &q.NotAfterAfter, "not_after_before": &q.NotAfterBefore} {
+		if get(k) == "" {
+			continue
+		}
+		t, err := parseTime(get(k))
+		if err != nil {
+			return q, fmt.Errorf("invalid %s, use YYYY-MM-DD or RFC 3339", k)
+		}
+		*p = t
+	}
+
+	for k, p := range map[string]*int{"limit": &q.Limit, "offset": &q.Offset} {
+		if get(k) == "" {
+			continue
+		}
+		n, err := strconv.Atoi(get(k))
+		if err != nil || n < 0 {
+			return q, fmt.Errorf("invalid %s", k)
+		}
+		*p = n
+	}
+	if q.Limit == 0 {
+		q.Limit = defaultLimit
+	}
+	if q.Limit > maxLimit {
+		q.Limit = maxLimit
+	}
+
+	switch get("order") {
+	case "", "desc":
+	case "asc":
+		q.Desc = false
+	default:
+		return q, fmt.Errorf("invalid order, use asc or desc")
+	}
+	return q, nil
+}
+
+func (s *Server) listMatches(w http.ResponseWriter, r *http.Request) {
+	q, err := ParseQuery(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	stored, err := s.Store.FindCerts(q)
+	if err != nil {
+		log.Printf("Can't query matches (%v)", err)
+		writeError(w, http.StatusInternalServerError, "storage error")
+		return
+	}
+
+	list := &MatchList{Matches: make([]*Match, 0, len(stored)), Offset: q.Offset, Limit: q.Limit}
+	for i := range stored {
+		list.Matches = append(list.Matches, NewMatch(&stored[i]))
+	}
+	if len(stored) == q.Limit {
+		next := q.Offset + q.Limit
+		list.NextOffset = &next
+	}
+	writeJSON(w, http.StatusOK, list)
+}
+
+func (s *Server) find(w http.ResponseWriter, fingerprint string) *db.CertInfo {
+	c, err := s.Store.FindCert(fingerprint)
+	if err == db.ErrNotFound {
+		writeError(w, http.StatusNotFound, "match not found")
+		return nil
+	}
+	if err != nil {
+		log.Printf("Can't load match %s (%v)", fingerprint, err)
+		writeError(w, http.StatusInternalServerError, "storage error")
+		return nil
+	}
+	return c
+}
+
+func (s *Server) getMatch(w http.ResponseWriter, fingerprint string) {
+	if c := s.find(w, fingerprint); c != nil {
+		writeJSON(w, http.StatusOK, NewMatch(c))
+	}
+}
+
+/* leaf only, or leaf with issuer chain as stored from the log */
+func (s *Server) getPEM(w http.ResponseWriter, fingerprint string, chain bool) {
+	c := s.find(w, fingerprint)
+	if c == nil {
+		return
+	}
+	block, _ := pem.Decode([]byte(c.PEMCert))
+	if block == nil {
+		writeError(w, http.StatusNotFound, "no certificate stored")
+		return
+	}
+	var body []byte
+	name := fingerprint + ".pem"
+	if chain {
+		body = certs.PEMBundle(block.Bytes, c.Chain)
+		name = fingerprint + "-chain.pem"
+	} else {
+		body = certs.PEMBundle(block.Bytes, nil)
+	}
+	w.Header().Set("Content-Type", "application/x-pem-file")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+	w.Write(body)
+}
+
+// Update converts |t| to storage update.
+func (t *TriageRequest) Update() (*db.TriageUpdate, error) {
+	u := &db.TriageUpdate{By: t.By, Assignee: t.Assignee, Note: strings.TrimSpace(t.Note)}
+	if u.By == "" {
+		u.By = "api"
+	}
+	if t.Status != nil {
+		status, err := models.ParseTriageStatus(*t.Status)
+		if err != nil {
+			return nil, err
+		}
+		u.Status = &status
+	}
+	return u, nil
+}
+
+func (s *Server) triage(w http.ResponseWriter, r *http.Request, fingerprint string) {
+	var t TriageRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64*1024)).Decode(&t); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON")
+		return
+	}
+	u, err := t.Update()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	c, err := s.Triage(fingerprint, u)
+	if err == db.ErrNotFound {
+		writeError(w, http.StatusNotFound, "match not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Can't change triage of %s (%v)", fingerprint, err)
+		writeError(w, http.StatusInternalServerError, "storage error")
+		return
+	}
+	writeJSON(w, http.StatusOK, NewMatch(c))
+}
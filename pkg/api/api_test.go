@@ -0,0 +1,93 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+func testServer(t *testing.T, token string) (*Server, func()) {
+	store, err := db.InitSQL("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.StoreCertDetails(&db.CertInfo{Fingerprint: "ab12", CommonName: "Login.Example.com",
+		DNSNames: []string{"Login.Example.com", "WWW.example.com"}}); err != nil {
+		t.Fatal(err)
+	}
+	triage := func(fingerprint string, u *db.TriageUpdate) (*db.CertInfo, error) {
+		c, _, err := store.UpdateTriage(fingerprint, u)
+		return c, err
+	}
+	return New(store, token, triage), func() { store.Close() }
+}
+
+func request(s *Server, method string, path string, token string, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	return w
+}
+
+func TestToken(t *testing.T) {
+	s, cleanup := testServer(t, "s3cret")
+	defer cleanup()
+
+	tests := []struct {
+		method, path, token, body string
+		status                    int
+	}{
+		{"GET", "/api/v1/matches", "", "", http.StatusUnauthorized},
+		{"GET", "/api/v1/matches", "wrong", "", http.StatusUnauthorized},
+		{"GET", "/api/v1/matches", "s3cret", "", http.StatusOK},
+		{"POST", "/api/v1/matches/ab12/triage", "", `{"status": "malicious"}`, http.StatusUnauthorized},
+		{"POST", "/api/v1/matches/ab12/triage", "s3cret", `{"status": "malicious"}`, http.StatusOK},
+	}
+	for _, tt := range tests {
+		if w := request(s, tt.method, tt.path, tt.token, tt.body); w.Code != tt.status {
+			t.Errorf("%s %s with token %q: %d, want %d", tt.method, tt.path, tt.token, w.Code, tt.status)
+		}
+	}
+}
+
+func TestReadOnlyWithoutToken(t *testing.T) {
+	s, cleanup := testServer(t, "")
+	defer cleanup()
+
+	if w := request(s, "GET", "/api/v1/matches/ab12", "", ""); w.Code != http.StatusOK {
+		t.Errorf("GET without token: %d", w.Code)
+	}
+	w := request(s, "POST", "/api/v1/matches/ab12/triage", "", `{"status": "legitimate"}`)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("triage without token: %d, want %d", w.Code, http.StatusForbidden)
+	}
+	c, err := s.Store.FindCert("ab12")
+	if err != nil || c.Status != models.TriageNew {
+		t.Errorf("status %q (%v) changed by read-only API", c.Status, err)
+	}
+}
+
+func TestNameCase(t *testing.T) {
+	s, cleanup := testServer(t, "")
+	defer cleanup()
+
+	for _, query := range []string{"name=login.example.com", "name=LOGIN.EXAMPLE.COM", "name=www.Example.com",
+		"name=*.EXAMPLE.com", "suffix=Example.COM"} {
+		w := request(s, "GET", "/api/v1/matches?"+query, "", "")
+		var list MatchList
+		if err := json.NewDecoder(w.Body).Decode(&list); err != nil || w.Code != http.StatusOK {
+			t.Fatalf("%s: %d (%v)", query, w.Code, err)
+		}
+		if len(list.Matches) != 1 || list.Matches[0].Fingerprint != "ab12" {
+			t.Errorf("%s: %d matches", query, len(list.Matches))
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package bus
+
+import (
+	"sync"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/metrics"
+)
+
+var (
+	published = metrics.NewCounter("ct_mon_bus_published_total", "Events published to sinks.")
+	depth     = metrics.NewGauge("ct_mon_sink_queue_depth", "Events queued for sink.", "sink")
+	dropped   = metrics.NewCounter("ct_mon_sink_dropped_total", "Events dropped on full sink queue.", "sink")
+)
+
+type subscriber struct {
+	name string
+	ch   chan models.MonEvent
+	drop bool
+}
+
+// Bus delivers events to subscribers through bounded queues, so a slow
+// subscriber doesn't block the scan unless it asks to.
+type Bus struct {
+	subs   []*subscriber
+	mu     sync.RWMutex
+	closed bool
+}
+
+func New() *Bus {
+	return &Bus{}
+}
+
+// Subscribe returns queue of |size| events for subscriber |name|. When the queue is full,
+// events are dropped if |drop| is set, publisher waits otherwise. Should be called before Publish.
+func (b *Bus) Subscribe(name string, size int, drop bool) chan models.MonEvent {
+	s := &subscriber{name: name, ch: make(chan models.MonEvent, size), drop: drop}
+	b.subs = append(b.subs, s)
+	depth.Func(func() float64 { return float64(len(s.ch)) }, name)
+	dropped.Add(0, name)
+	return s.ch
+}
+
+// Publish queues |e| for every subscriber, CT_QUIT is never dropped. Returns names of
+// subscribers which dropped the event. Events published after Close are ignored.
+func (b *Bus) Publish(e models.MonEvent) []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return nil
+	}
+	return b.publish(e)
+}
+
+func (b *Bus) publish(e models.MonEvent) []string {
+	published.Inc()
+	var names []string
+	for _, s := range b.subs {
+		if !s.drop || e.Type == models.CT_QUIT {
+			s.ch <- e
+			continue
+		}
+		select {
+		case s.ch <- e:
+		default:
+			dropped.Inc(s.name)
+			names = append(names, s.name)
+		}
+	}
+	return names
+}
+
+// Close queues CT_QUIT for every subscriber after already queued events.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	b.publish(models.MonEvent{Type: models.CT_QUIT})
+}
@@ -0,0 +1,100 @@
+package bus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+func event(fingerprint string) models.MonEvent {
+	return models.MonEvent{Type: models.CT_CERT, Fingerprint: fingerprint}
+}
+
+/* fails unless |ch| has exactly |want| events queued, in order */
+func expect(t *testing.T, name string, ch chan models.MonEvent, want ...string) {
+	for _, fp := range want {
+		select {
+		case e := <-ch:
+			if e.Fingerprint != fp {
+				t.Errorf("%s got %q, want %q", name, e.Fingerprint, fp)
+			}
+		default:
+			t.Errorf("%s has no %q", name, fp)
+		}
+	}
+	if n := len(ch); n != 0 {
+		t.Errorf("%s has %d more events", name, n)
+	}
+}
+
+func TestDropQueue(t *testing.T) {
+	b := New()
+	slow := b.Subscribe("drop-slow", 1, true)
+	fast := b.Subscribe("drop-fast", 3, true)
+	before := dropped.Value("drop-slow")
+
+	if names := b.Publish(event("e1")); len(names) != 0 {
+		t.Errorf("dropped by %v", names)
+	}
+	if names := b.Publish(event("e2")); len(names) != 1 || names[0] != "drop-slow" {
+		t.Errorf("dropped by %v, want [drop-slow]", names)
+	}
+	if n := dropped.Value("drop-slow") - before; n != 1 {
+		t.Errorf("%v dropped events counted, want 1", n)
+	}
+	expect(t, "slow", slow, "e1")
+	expect(t, "fast", fast, "e1", "e2")
+}
+
+func TestBlockingQueue(t *testing.T) {
+	b := New()
+	ch := b.Subscribe("block", 1, false)
+	b.Publish(event("e1"))
+
+	done := make(chan []string)
+	go func() { done <- b.Publish(event("e2")) }()
+	select {
+	case <-done:
+		t.Fatal("publish to full queue doesn't wait")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if e := <-ch; e.Fingerprint != "e1" {
+		t.Errorf("got %q, want e1", e.Fingerprint)
+	}
+	if names := <-done; len(names) != 0 {
+		t.Errorf("blocking queue dropped by %v", names)
+	}
+	expect(t, "block", ch, "e2")
+}
+
+func TestClose(t *testing.T) {
+	b := New()
+	drop := b.Subscribe("close-drop", 1, true)
+	block := b.Subscribe("close-block", 2, false)
+	b.Publish(event("e1"))
+
+	/* CT_QUIT waits for room even in a drop queue */
+	closed := make(chan struct{})
+	go func() {
+		b.Close()
+		close(closed)
+	}()
+	for _, ch := range []chan models.MonEvent{drop, block} {
+		if e := <-ch; e.Fingerprint != "e1" {
+			t.Errorf("got %q before quit, want e1", e.Fingerprint)
+		}
+		if e := <-ch; e.Type != models.CT_QUIT {
+			t.Errorf("got %v, want CT_QUIT", e.Type)
+		}
+	}
+	<-closed
+
+	if names := b.Publish(event("e2")); names != nil {
+		t.Errorf("publish after close dropped by %v", names)
+	}
+	b.Close()
+	expect(t, "drop", drop)
+	expect(t, "block", block)
+}
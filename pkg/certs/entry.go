@@ -0,0 +1,36 @@
+package certs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/certificate-transparency/go"
+	"github.com/google/certificate-transparency/go/x509"
+)
+
+// Fingerprint returns hex SHA256 of the leaf certificate,
+// TBSCertificate is used for precertificates.
+func Fingerprint(entry *ct.LogEntry) string {
+	var sum [sha256.Size]byte
+	if entry.Precert != nil {
+		sum = sha256.Sum256(entry.Precert.TBSCertificate.Raw)
+	} else {
+		sum = sha256.Sum256(entry.X509Cert.Raw)
+	}
+	return hex.EncodeToString(sum[:])
+}
+
+// Timestamp returns log timestamp of |entry|.
+func Timestamp(entry *ct.LogEntry) time.Time {
+	ms := int64(entry.Leaf.TimestampedEntry.Timestamp)
+	return time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond)).UTC()
+}
+
+// Leaf returns parsed certificate of |entry|, TBSCertificate for precertificates.
+func Leaf(entry *ct.LogEntry) *x509.Certificate {
+	if entry.Precert != nil {
+		return &entry.Precert.TBSCertificate
+	}
+	return entry.X509Cert
+}
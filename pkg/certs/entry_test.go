@@ -0,0 +1,25 @@
+package certs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyprizel/ct_mon/pkg/cttest"
+)
+
+/* get-entries of a precertificate (index 0) and its final certificate (index 1) */
+const precertEntries = "testdata/precert-entries.json"
+
+func TestFingerprint(t *testing.T) {
+	entries := cttest.Entries(t, precertEntries)
+	pre, cert := Fingerprint(entries[0]), Fingerprint(entries[1])
+	if len(pre) != 64 || len(cert) != 64 || pre == cert {
+		t.Errorf("bad fingerprints %q %q", pre, cert)
+	}
+	if Leaf(entries[0]) != &entries[0].Precert.TBSCertificate || Leaf(entries[1]) != entries[1].X509Cert {
+		t.Error("wrong leaf certificates")
+	}
+	if ts := Timestamp(entries[0]); !ts.Equal(time.Date(2016, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("timestamp %v", ts)
+	}
+}
@@ -0,0 +1,101 @@
+package certs
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+
+	"github.com/google/certificate-transparency/go"
+)
+
+func writeUint(buf *bytes.Buffer, v uint64, size int) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	buf.Write(b[8-size:])
+}
+
+func writeVarBytes(buf *bytes.Buffer, data []byte, lenSize int) {
+	writeUint(buf, uint64(len(data)), lenSize)
+	buf.Write(data)
+}
+
+// LeafInput returns RFC 6962 MerkleTreeLeaf of |entry| as it was served in get-entries leaf_input.
+func LeafInput(entry *ct.LogEntry) []byte {
+	leaf := entry.Leaf
+	te := leaf.TimestampedEntry
+
+	var buf bytes.Buffer
+	buf.WriteByte(byte(leaf.Version))
+	buf.WriteByte(byte(leaf.LeafType))
+	writeUint(&buf, te.Timestamp, 8)
+	writeUint(&buf, uint64(te.EntryType), 2)
+	switch te.EntryType {
+	case ct.X509LogEntryType:
+		writeVarBytes(&buf, te.X509Entry, 3)
+	case ct.PrecertLogEntryType:
+		buf.Write(te.PrecertEntry.IssuerKeyHash[:])
+		writeVarBytes(&buf, te.PrecertEntry.TBSCertificate, 3)
+	}
+	writeVarBytes(&buf, te.Extensions, 2)
+	return buf.Bytes()
+}
+
+// ExtraData returns RFC 6962 get-entries extra_data of |entry|: certificate chain,
+// precertificate followed by its chain for precert entries.
+func ExtraData(entry *ct.LogEntry) []byte {
+	var chain bytes.Buffer
+	for _, c := range Chain(entry) {
+		writeVarBytes(&chain, c, 3)
+	}
+
+	var buf bytes.Buffer
+	if entry.Precert != nil {
+		writeVarBytes(&buf, entry.Precert.Raw, 3)
+	}
+	writeVarBytes(&buf, chain.Bytes(), 3)
+	return buf.Bytes()
+}
+
+// DER returns leaf certificate of |entry|, full precertificate with poison for precert entries.
+func DER(entry *ct.LogEntry) []byte {
+	if entry.Precert != nil {
+		return entry.Precert.Raw
+	}
+	return entry.X509Cert.Raw
+}
+
+// Chain returns issuer chain of |entry|.
+func Chain(entry *ct.LogEntry) [][]byte {
+	issuers := entry.Chain
+	/* the log puts precertificate itself first */
+	if entry.Precert != nil && len(issuers) > 0 {
+		issuers = issuers[1:]
+	}
+	chain := make([][]byte, 0, len(issuers))
+	for _, c := range issuers {
+		chain = append(chain, c)
+	}
+	return chain
+}
+
+// PEMBundle encodes |leaf| followed by |chain| as PEM.
+func PEMBundle(leaf []byte, chain [][]byte) []byte {
+	var buf bytes.Buffer
+	pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: leaf})
+	for _, c := range chain {
+		pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: c})
+	}
+	return buf.Bytes()
+}
+
+// LogID returns base64 log ID for log public key |key| given as base64 DER, like in log lists.
+func LogID(key string) (string, error) {
+	der, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return base64.StdEncoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,66 @@
+package certs
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"testing"
+
+	"github.com/kyprizel/ct_mon/pkg/cttest"
+)
+
+func TestLeafInputExtraData(t *testing.T) {
+	data, err := ioutil.ReadFile(precertEntries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var served struct {
+		Entries []struct {
+			LeafInput string `json:"leaf_input"`
+			ExtraData string `json:"extra_data"`
+		} `json:"entries"`
+	}
+	if err := json.Unmarshal(data, &served); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, entry := range cttest.Entries(t, precertEntries) {
+		if got := base64.StdEncoding.EncodeToString(LeafInput(entry)); got != served.Entries[i].LeafInput {
+			t.Errorf("entry %d: leaf_input differs from served", i)
+		}
+		if got := base64.StdEncoding.EncodeToString(ExtraData(entry)); got != served.Entries[i].ExtraData {
+			t.Errorf("entry %d: extra_data differs from served", i)
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	entries := cttest.Entries(t, precertEntries)
+	pre, cert := entries[0], entries[1]
+	if !bytes.Equal(DER(pre), pre.Chain[0]) || !bytes.Equal(DER(cert), cert.X509Cert.Raw) {
+		t.Error("wrong leaf DER")
+	}
+	/* both are issued by the same CA */
+	for i, entry := range entries {
+		chain := Chain(entry)
+		if len(chain) != 1 || !bytes.Equal(chain[0], cert.Chain[0]) {
+			t.Errorf("entry %d: chain of %d certificates, want the issuer only", i, len(chain))
+		}
+	}
+
+	var blocks int
+	for rest := PEMBundle(DER(pre), Chain(pre)); ; blocks++ {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			break
+		}
+		if blocks > 0 && bytes.Equal(block.Bytes, DER(pre)) {
+			t.Error("precertificate repeated in PEM bundle")
+		}
+	}
+	if blocks != 2 {
+		t.Errorf("PEM bundle of %d certificates, want 2", blocks)
+	}
+}
@@ -0,0 +1,81 @@
+package certs
+
+import (
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/hex"
+
+	"github.com/google/certificate-transparency/go/x509"
+)
+
+var (
+	oidCTPoison  = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3}
+	oidSCTList   = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2}
+	ctExtensions = []asn1.ObjectIdentifier{oidCTPoison, oidSCTList}
+)
+
+/* stdlib asn1 types only, the fork's asn1 doesn't decode into stdlib structs */
+type extension struct {
+	Id       asn1.ObjectIdentifier
+	Critical bool `asn1:"optional"`
+	Value    []byte
+}
+
+type rawTBSCertificate struct {
+	Raw             asn1.RawContent
+	Version         int `asn1:"optional,explicit,default:0,tag:0"`
+	SerialNumber    asn1.RawValue
+	Signature       asn1.RawValue
+	Issuer          asn1.RawValue
+	Validity        asn1.RawValue
+	Subject         asn1.RawValue
+	PublicKey       asn1.RawValue
+	IssuerUniqueId  asn1.BitString `asn1:"optional,tag:1"`
+	SubjectUniqueId asn1.BitString `asn1:"optional,tag:2"`
+	Extensions      []extension    `asn1:"optional,explicit,tag:3"`
+}
+
+func isCTExtension(id asn1.ObjectIdentifier) bool {
+	for _, oid := range ctExtensions {
+		if id.Equal(oid) {
+			return true
+		}
+	}
+	return false
+}
+
+// RawTBS returns DER TBSCertificate of |c|, parsed precertificates carry only TBS in Raw.
+func RawTBS(c *x509.Certificate) []byte {
+	if len(c.RawTBSCertificate) > 0 {
+		return c.RawTBSCertificate
+	}
+	return c.Raw
+}
+
+// StripCTExtensions re-encodes |tbs| without poison and embedded SCT list extensions.
+func StripCTExtensions(tbs []byte) ([]byte, error) {
+	var t rawTBSCertificate
+	if _, err := asn1.Unmarshal(tbs, &t); err != nil {
+		return nil, err
+	}
+	exts := t.Extensions[:0]
+	for _, e := range t.Extensions {
+		if !isCTExtension(e.Id) {
+			exts = append(exts, e)
+		}
+	}
+	t.Extensions = exts
+	t.Raw = nil
+	return asn1.Marshal(t)
+}
+
+// TBSHash returns hex SHA256 of |c| TBSCertificate with CT extensions removed,
+// precertificate and its final certificate have the same TBSHash.
+func TBSHash(c *x509.Certificate) (string, error) {
+	tbs, err := StripCTExtensions(RawTBS(c))
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(tbs)
+	return hex.EncodeToString(sum[:]), nil
+}
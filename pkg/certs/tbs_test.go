@@ -0,0 +1,55 @@
+package certs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/certificate-transparency/go/x509"
+
+	"github.com/kyprizel/ct_mon/pkg/cttest"
+)
+
+func TestTBSHash(t *testing.T) {
+	entries := cttest.Entries(t, precertEntries)
+	pre, err := TBSHash(&entries[0].Precert.TBSCertificate)
+	if err != nil {
+		t.Fatalf("precert TBS: %v", err)
+	}
+	cert, err := TBSHash(entries[1].X509Cert)
+	if err != nil {
+		t.Fatalf("cert TBS: %v", err)
+	}
+	if pre != cert {
+		t.Errorf("precert TBS hash %s, final certificate %s", pre, cert)
+	}
+	issuer, err := x509.ParseCertificate(entries[1].Chain[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h, err := TBSHash(issuer); err != nil || h == cert {
+		t.Errorf("issuer TBS hash %q (%v)", h, err)
+	}
+}
+
+func TestStripCTExtensions(t *testing.T) {
+	entries := cttest.Entries(t, precertEntries)
+	cert := entries[1].X509Cert
+	stripped, err := StripCTExtensions(RawTBS(cert))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(stripped, cert.RawTBSCertificate) {
+		t.Error("SCT list extension is not removed")
+	}
+	if !bytes.Equal(stripped, entries[0].Precert.TBSCertificate.Raw) {
+		t.Error("stripped certificate TBS differs from precertificate TBS")
+	}
+	/* nothing to strip in precert TBS from the log, DER is kept as is */
+	again, err := StripCTExtensions(stripped)
+	if err != nil || !bytes.Equal(again, stripped) {
+		t.Errorf("re-encoding changed TBS (%v)", err)
+	}
+	if _, err := StripCTExtensions([]byte{0x30, 0x03, 0x02, 0x01}); err == nil {
+		t.Error("truncated TBS accepted")
+	}
+}
@@ -0,0 +1,332 @@
+package chat
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+const (
+	Slack      = "slack"
+	Mattermost = "mattermost"
+	Teams      = "teams"
+
+	/* names and summary lines shown in one message */
+	maxLines = 20
+)
+
+var colors = map[models.Severity]string{
+	models.SeverityLow:      "#439FE0",
+	models.SeverityMedium:   "#DAA038",
+	models.SeverityHigh:     "#E8600A",
+	models.SeverityCritical: "#D00000",
+}
+
+// Route is incoming webhook and channel override (not supported by Teams) to post to.
+type Route struct {
+	URL     string `json:"url"`
+	Channel string `json:"channel"`
+}
+
+type field struct {
+	Title string
+	Value string
+	Short bool
+}
+
+type message struct {
+	Title  string
+	Link   string
+	Color  string
+	Fields []field
+}
+
+type batch struct {
+	matches []*models.Match
+	/* called with the summary post result, nil for matches given to Notify */
+	acks  []func(error)
+	timer *time.Timer
+}
+
+// CertHandler posts a card per match to Slack, Mattermost or Teams. Matches
+// coming within |Window| seconds after a card are collapsed into one summary message.
+type CertHandler struct {
+	Flavor string `json:"-"`
+	Route
+	/* rule name -> route, route of the first hit rule is used */
+	RuleRoutes map[string]Route `json:"rule_routes"`
+	Window     int              `json:"collapse_window"`
+	Username   string           `json:"username"`
+
+	mu      sync.Mutex
+	pending map[Route]*batch
+	client  *http.Client
+}
+
+// New creates |flavor| handler from |conf|, collapse window is in seconds.
+func New(flavor string, conf []byte) (*CertHandler, error) {
+	s := &CertHandler{Flavor: flavor, Window: 60, Username: "ct_mon"}
+	if err := json.Unmarshal(conf, s); err != nil {
+		return nil, err
+	}
+	if s.URL == "" {
+		return nil, errors.New("url is not set")
+	}
+	for rule, r := range s.RuleRoutes {
+		if r.URL == "" {
+			r.URL = s.URL
+			s.RuleRoutes[rule] = r
+		}
+	}
+	s.pending = make(map[Route]*batch)
+	s.client = &http.Client{Timeout: 10 * time.Second}
+	return s, nil
+}
+
+func (s *CertHandler) route(m *models.Match) Route {
+	for _, rule := range m.Rules {
+		if r, ok := s.RuleRoutes[rule]; ok {
+			return r
+		}
+	}
+	return s.Route
+}
+
+/* collapsed matches are not posted yet when it returns */
+func (s *CertHandler) Notify(m *models.Match) error {
+	if s.collapse(m, nil) {
+		return nil
+	}
+	return s.post(s.route(m), s.card(m))
+}
+
+// Hold posts card of |m| or collapses it to summary, |done| gets the result once it is posted.
+func (s *CertHandler) Hold(m *models.Match, done func(error)) {
+	if !s.collapse(m, done) {
+		done(s.post(s.route(m), s.card(m)))
+	}
+}
+
+/* adds |m| to collapse window of its route, false if there's none and card should be posted */
+func (s *CertHandler) collapse(m *models.Match, done func(error)) bool {
+	r := s.route(m)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if b := s.pending[r]; b != nil {
+		b.matches = append(b.matches, m)
+		b.acks = append(b.acks, done)
+		return true
+	}
+	s.open(r)
+	return false
+}
+
+/* starts collapse window of |r|, called with |mu| held */
+func (s *CertHandler) open(r Route) {
+	if s.Window <= 0 {
+		return
+	}
+	b := &batch{}
+	b.timer = time.AfterFunc(time.Duration(s.Window)*time.Second, func() { s.flush(r, true) })
+	s.pending[r] = b
+}
+
+func (s *CertHandler) flush(r Route, reopen bool) {
+	s.mu.Lock()
+	b := s.pending[r]
+	delete(s.pending, r)
+	/* keep collapsing while matches keep coming */
+	if reopen && b != nil && len(b.matches) > 0 {
+		s.open(r)
+	}
+	s.mu.Unlock()
+
+	if b == nil || len(b.matches) == 0 {
+		return
+	}
+	err := s.post(r, s.summary(b.matches))
+	if err != nil {
+		log.Printf("Error sending %s summary (%v)", s.Flavor, err)
+	}
+	for _, done := range b.acks {
+		if done != nil {
+			done(err)
+		}
+	}
+}
+
+func crtsh(m *models.Match) string {
+	return "https://crt.sh/?sha256=" + m.SHA256
+}
+
+func (s *CertHandler) link(url string, text string) string {
+	if s.Flavor == Slack {
+		return fmt.Sprintf("<%s|%s>", url, text)
+	}
+	return fmt.Sprintf("[%s](%s)", text, url)
+}
+
+func limit(lines []string) []string {
+	if len(lines) > maxLines {
+		return append(lines[:maxLines:maxLines], fmt.Sprintf("and %d more", len(lines)-maxLines))
+	}
+	return lines
+}
+
+func (s *CertHandler) card(m *models.Match) *message {
+	title := "New certificate: " + m.CommonName
+	if m.Escalated {
+		title = "Escalated certificate: " + m.CommonName
+	} else if m.Precert {
+		title = "New precertificate: " + m.CommonName
+	} else if m.FinalForPrecert {
+		title = "Final certificate for reported precert: " + m.CommonName
+	}
+	return &message{Title: title, Link: crtsh(m), Color: colors[m.Severity],
+		Fields: []field{
+			{Title: "Domains", Value: strings.Join(limit(m.DNSNames), "\n")},
+			{Title: "Issuer", Value: m.Issuer, Short: true},
+			{Title: "Validity", Value: m.NotBefore.Format("2006-01-02") + " - " + m.NotAfter.Format("2006-01-02"), Short: true},
+			{Title: "Rules", Value: fmt.Sprintf("%s (%s)", strings.Join(m.Rules, ", "), m.Severity), Short: true},
+			{Title: "Log", Value: fmt.Sprintf("%s #%d", m.Log, m.Index), Short: true},
+		}}
+}
+
+/* log health is posted without collapsing */
+func (s *CertHandler) NotifyHealth(h *models.LogHealth) error {
+	msg := &message{Title: h.Title(), Color: colors[h.Severity],
+		Fields: []field{
+			{Title: "Log", Value: h.Log, Short: true},
+			{Title: "Since", Value: h.Since.Format("2006-01-02 15:04 MST"), Short: true},
+			{Title: "Details", Value: h.Message},
+		}}
+	return s.post(s.Route, msg)
+}
+
+/* triage changes go to the route of the match without collapsing */
+func (s *CertHandler) NotifyTriage(t *models.TriageEvent) error {
+	fields := []field{
+		{Title: "Status", Value: string(t.Status), Short: true},
+		{Title: "Assignee", Value: t.Assignee, Short: true},
+	}
+	if note := t.Note(); note != "" {
+		fields = append(fields, field{Title: "Note", Value: note})
+	}
+	msg := &message{Title: t.Title(), Link: crtsh(t.Match), Color: colors[t.Match.Severity], Fields: fields}
+	return s.post(s.route(t.Match), msg)
+}
+
+func (s *CertHandler) summary(matches []*models.Match) *message {
+	var severity models.Severity
+	var lines []string
+	for _, m := range matches {
+		if m.Severity.Level() > severity.Level() {
+			severity = m.Severity
+		}
+		lines = append(lines, fmt.Sprintf("%s (%s)", s.link(crtsh(m), m.CommonName), strings.Join(m.Rules, ", ")))
+	}
+	return &message{Title: fmt.Sprintf("%d more matches in %d seconds", len(matches), s.Window),
+		Color:  colors[severity],
+		Fields: []field{{Title: "Matches", Value: strings.Join(limit(lines), "\n")}}}
+}
+
+/* Slack and Mattermost share incoming webhook format */
+func (s *CertHandler) slackPayload(r Route, msg *message) interface{} {
+	fields := make([]map[string]interface{}, 0, len(msg.Fields))
+	for _, f := range msg.Fields {
+		fields = append(fields, map[string]interface{}{"title": f.Title, "value": f.Value, "short": f.Short})
+	}
+	payload := map[string]interface{}{
+		"username": s.Username,
+		"text":     msg.Title,
+		"attachments": []map[string]interface{}{{
+			"fallback":   msg.Title,
+			"color":      msg.Color,
+			"title":      msg.Title,
+			"title_link": msg.Link,
+			"fields":     fields,
+		}},
+	}
+	if r.Channel != "" {
+		payload["channel"] = r.Channel
+	}
+	return payload
+}
+
+func (s *CertHandler) teamsPayload(msg *message) interface{} {
+	facts := make([]map[string]string, 0, len(msg.Fields))
+	for _, f := range msg.Fields {
+		/* Teams markdown needs two spaces before line break */
+		facts = append(facts, map[string]string{"name": f.Title, "value": strings.Replace(f.Value, "\n", "  \n", -1)})
+	}
+	payload := map[string]interface{}{
+		"@type":      "MessageCard",
+		"@context":   "http://schema.org/extensions",
+		"summary":    msg.Title,
+		"themeColor": strings.TrimPrefix(msg.Color, "#"),
+		"title":      msg.Title,
+		"sections":   []map[string]interface{}{{"facts": facts}},
+	}
+	if msg.Link != "" {
+		payload["potentialAction"] = []map[string]interface{}{{
+			"@type":   "OpenUri",
+			"name":    "View on crt.sh",
+			"targets": []map[string]string{{"os": "default", "uri": msg.Link}},
+		}}
+	}
+	return payload
+}
+
+func (s *CertHandler) post(r Route, msg *message) error {
+	var payload interface{}
+	if s.Flavor == Teams {
+		payload = s.teamsPayload(msg)
+	} else {
+		payload = s.slackPayload(r, msg)
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Post(r.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s returned %s", s.Flavor, resp.Status)
+	}
+	return nil
+}
+
+// Flush sends summaries of pending matches.
+func (s *CertHandler) Flush() error {
+	s.mu.Lock()
+	routes := make([]Route, 0, len(s.pending))
+	for r, b := range s.pending {
+		b.timer.Stop()
+		routes = append(routes, r)
+	}
+	s.mu.Unlock()
+
+	for _, r := range routes {
+		s.flush(r, false)
+	}
+	return nil
+}
+
+func (s *CertHandler) Close() error {
+	return s.Flush()
+}
@@ -0,0 +1,85 @@
+// Package cttest serves CT log fixtures to tests of other packages.
+package cttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/certificate-transparency/go"
+	"github.com/google/certificate-transparency/go/client"
+	"github.com/google/certificate-transparency/go/x509"
+)
+
+/* number of entries in get-entries response |file| */
+func count(file string) (int, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return 0, err
+	}
+	var resp struct {
+		Entries []json.RawMessage `json:"entries"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return 0, err
+	}
+	return len(resp.Entries), nil
+}
+
+// NewLog serves get-entries response |file| as a log with all of its entries in the tree.
+func NewLog(t *testing.T, file string) *httptest.Server {
+	n, err := count(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sth := fmt.Sprintf(`{"tree_size":%d,"timestamp":1456790400000,`+
+		`"sha256_root_hash":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=","tree_head_signature":"BAMAAQA="}`, n)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ct/v1/get-sth":
+			w.Write([]byte(sth))
+		case "/ct/v1/get-entries":
+			http.ServeFile(w, r, file)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+// Entries returns entries of |file| parsed as the scanner hands them to ct_mon. The scanner
+// itself is not run, it isn't race-free.
+func Entries(t *testing.T, file string) []*ct.LogEntry {
+	srv := NewLog(t, file)
+	defer srv.Close()
+	n, _ := count(file)
+	fetched, err := client.New(srv.URL).GetEntries(0, int64(n)-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []*ct.LogEntry
+	for i := range fetched {
+		entry := &fetched[i]
+		leaf := &entry.Leaf.TimestampedEntry
+		switch leaf.EntryType {
+		case ct.X509LogEntryType:
+			cert, err := x509.ParseCertificate(leaf.X509Entry)
+			if _, ok := err.(x509.NonFatalErrors); err != nil && !ok {
+				t.Fatalf("entry %d: %v", entry.Index, err)
+			}
+			entry.X509Cert = cert
+		case ct.PrecertLogEntryType:
+			tbs, err := x509.ParseTBSCertificate(leaf.PrecertEntry.TBSCertificate)
+			if _, ok := err.(x509.NonFatalErrors); err != nil && !ok {
+				t.Fatalf("entry %d: %v", entry.Index, err)
+			}
+			entry.Precert = &ct.Precertificate{Raw: entry.Chain[0], TBSCertificate: *tbs,
+				IssuerKeyHash: leaf.PrecertEntry.IssuerKeyHash}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
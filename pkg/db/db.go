@@ -1,20 +1,23 @@
 package db
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
-	"encoding/pem"
+	"errors"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 
 	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/certs"
+	"github.com/kyprizel/ct_mon/pkg/lint"
 )
 
 type MonDBState struct {
 	Id         bson.ObjectId `json:"id,omitempty" bson:"_id"`
+	LogUri     string        `bson:"log_uri,omitempty"`
 	StartIndex int64         `bson:"start_index"`
 	Created    time.Time     `bson:"created"`
 	Updated    time.Time     `bson:"updated"`
@@ -22,23 +25,43 @@ type MonDBState struct {
 
 type CertInfo struct {
 	Id                    bson.ObjectId `json:"id,omitempty" bson:"_id"`
+	Fingerprint           string        `bson:"fingerprint"`
+	Log                   string        `bson:"log"`
 	Index                 int64
-	CommonName            string    `bson:"CommonName"`
-	Issuer                string    `bson:"Issuer"`
-	Serial                string    `bson:"Serial"`
-	NotBefore             time.Time `bson:"NotBefore"`
-	NotAfter              time.Time `bson:"NotAfter"`
-	KeyUsage              int       `bson:"KeyUsage"`
-	PublicKeyAlgorithm    int       `bson:"PublicKeyAlgorithm"`
-	SignatureAlgorithm    int       `bson:"SignatureAlgorithm"`
-	DNSNames              []string  `bson:"DNSNames"`
-	EmailAddresses        []string  `bson:"EmailAddresses"`
-	OCSPServer            []string  `bson:"OCSPServer"`
-	IssuingCertificateURL []string  `bson:"IssuingCertificateURL"`
-	PEMCert               string    `bson:"pem"`
-	Precert               bool      `bson:"precert"`
-	Created               time.Time `bson:"created"`
-	SHA256Sum             string    `bson:"sha256_sum"`
+	CommonName            string        `bson:"CommonName"`
+	Issuer                string        `bson:"Issuer"`
+	Serial                string        `bson:"Serial"`
+	NotBefore             time.Time     `bson:"NotBefore"`
+	NotAfter              time.Time     `bson:"NotAfter"`
+	KeyUsage              int           `bson:"KeyUsage"`
+	PublicKeyAlgorithm    int           `bson:"PublicKeyAlgorithm"`
+	SignatureAlgorithm    int           `bson:"SignatureAlgorithm"`
+	DNSNames              []string      `bson:"DNSNames"`
+	EmailAddresses        []string      `bson:"EmailAddresses"`
+	OCSPServer            []string      `bson:"OCSPServer"`
+	IssuingCertificateURL []string      `bson:"IssuingCertificateURL"`
+	PEMCert               string        `bson:"pem"`
+	Precert               bool          `bson:"precert"`
+	Created               time.Time     `bson:"created"`
+	SHA256Sum             string        `bson:"sha256_sum"`
+	Lints                 []lint.Result `bson:"lints"`
+	TBSHash               string        `bson:"tbs_hash"`
+	PrecertSeen           bool          `bson:"precert_seen"`
+	Suppressed            bool          `bson:"suppressed"`
+	LeafInput             []byte        `bson:"leaf_input"`
+	ExtraData             []byte        `bson:"extra_data"`
+	Chain                 [][]byte      `bson:"chain"`
+	Timestamp             time.Time     `bson:"timestamp"`
+	LogID                 string        `bson:"log_id"`
+	Rules                 []string      `bson:"rules"`
+	Severity              string        `bson:"severity"`
+	/* lowercase CommonName and DNSNames for name lookups, DNS names are case-insensitive */
+	Names []string `bson:"names" json:"-"`
+
+	/* triage state, status is empty for certificates stored before triage */
+	Status   models.TriageStatus   `bson:"status"`
+	Assignee string                `bson:"assignee,omitempty"`
+	History  []models.TriageChange `bson:"history,omitempty"`
 }
 
 type MonDB struct {
@@ -54,9 +77,31 @@ func Init(uri string) (*MonDB, error) {
 	}
 	m := &MonDB{uri: uri, session: session}
 
+	if err := m.Migrate(); err != nil {
+		log.Printf("DB schema migration error (%v)\n", err)
+		return nil, err
+	}
+
 	return m, nil
 }
 
+func (m *MonDB) Close() error {
+	if m.session != nil {
+		m.session.Close()
+	}
+	return nil
+}
+
+// Ping checks DB is reachable.
+func (m *MonDB) Ping() error {
+	session, err := m.getSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+	return session.Ping()
+}
+
 func (m *MonDB) getSession() (*mgo.Session, error) {
 	if m.session == nil {
 		var err error
@@ -65,123 +110,320 @@ func (m *MonDB) getSession() (*mgo.Session, error) {
 			log.Printf("DB connection error (%v)\n", err)
 			return nil, err
 		}
-		return m.session, nil
 	}
 
 	return m.session.Copy(), nil
 }
 
-func (m *MonDB) LoadState() (int64, error) {
+func stateQuery(logUri string) bson.M {
+	/* state saved before multiple logs support has no log_uri */
+	if logUri == "" {
+		return bson.M{"log_uri": bson.M{"$exists": false}}
+	}
+	return bson.M{"log_uri": logUri}
+}
+
+func (m *MonDB) LoadState(logUri string) (int64, error) {
 	session, err := m.getSession()
 	if err != nil {
 		log.Printf("DB connection error (%v)\n", err)
 		return 0, err
 	}
+	defer session.Close()
 	col := session.DB("").C("state")
 
 	result := MonDBState{}
-	err = col.Find(nil).Sort("-updated").One(&result)
+	err = col.Find(stateQuery(logUri)).Sort("-updated").One(&result)
 	if err != nil {
-		return 0, err
+		return 0, mgoNotFound(err)
 	}
 
 	return result.StartIndex, nil
 }
 
-func (m *MonDB) SaveState(StartIndex int64) error {
+func (m *MonDB) SaveState(logUri string, StartIndex int64) error {
 	session, err := m.getSession()
 	if err != nil {
 		log.Printf("DB connection error (%v)\n", err)
 		return err
 	}
+	defer session.Close()
 
 	col := session.DB("").C("state")
-	var needInsert bool
-	state := MonDBState{}
-	err = col.Find(nil).Sort("-updated").One(&state)
+	now := time.Now().UTC()
+	change := bson.M{"$set": bson.M{"start_index": StartIndex, "updated": now},
+		"$setOnInsert": bson.M{"created": now}}
+	_, err = col.Upsert(bson.M{"log_uri": logUri}, change)
+	return err
+}
+
+/* lowercase CN and SANs of |c| without duplicates */
+func lowerNames(c *CertInfo) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range append([]string{c.CommonName}, c.DNSNames...) {
+		name = strings.ToLower(name)
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (m *MonDB) StoreCertDetails(cert *CertInfo) error {
+	session, err := m.getSession()
 	if err != nil {
-		needInsert = true
+		log.Printf("DB connection error (%v)\n", err)
+		return err
+	}
+	defer session.Close()
+
+	col := session.DB("").C("certificate_details")
+	/* do not store same certificate more than once, it can be seen in several logs */
+	cert.Id = bson.NewObjectId()
+	cert.Created = time.Now().UTC()
+	cert.Names = lowerNames(cert)
+	if cert.Status == "" {
+		cert.Status = models.TriageNew
 	}
+	return upsert(col, bson.M{"fingerprint": cert.Fingerprint}, bson.M{"$setOnInsert": cert})
+}
 
-	if !needInsert {
-		qs := bson.M{"_id": state.Id}
-		change := bson.M{"$set": bson.M{"start_index": StartIndex, "updated": time.Now().UTC()}}
-		return col.Update(qs, change)
+/* callers compare with ErrNotFound only, not with driver errors */
+func mgoNotFound(err error) error {
+	if err == mgo.ErrNotFound {
+		return ErrNotFound
 	}
+	return err
+}
 
-	state.Id = bson.NewObjectId()
-	state.StartIndex = StartIndex
-	state.Created = time.Now().UTC()
-	state.Updated = state.Created
-	return col.Insert(state)
+/* concurrent upserts of the same key can race on unique index, retry once */
+func upsert(col *mgo.Collection, selector interface{}, change interface{}) error {
+	_, err := upsertInfo(col, selector, change)
+	return err
 }
 
-func (m *MonDB) StoreCertDetails(cert *CertInfo) error {
+func upsertInfo(col *mgo.Collection, selector interface{}, change interface{}) (*mgo.ChangeInfo, error) {
+	info, err := col.Upsert(selector, change)
+	if mgo.IsDup(err) {
+		info, err = col.Upsert(selector, change)
+	}
+	return info, err
+}
+
+func (m *MonDB) FindCert(fingerprint string) (*CertInfo, error) {
 	session, err := m.getSession()
 	if err != nil {
 		log.Printf("DB connection error (%v)\n", err)
-		return err
+		return nil, err
 	}
+	defer session.Close()
 
 	col := session.DB("").C("certificate_details")
-	/* do not store same entry more than once */
-	var cnt int
-	cnt, err = col.Find(bson.M{"index": cert.Index}).Count()
-	if cnt < 1 {
-		cert.Id = bson.NewObjectId()
-		cert.Created = time.Now().UTC()
-		return col.Insert(cert)
+	cert := &CertInfo{}
+	err = col.Find(bson.M{"fingerprint": fingerprint}).One(cert)
+	if err != nil {
+		return nil, mgoNotFound(err)
 	}
-	return nil;
+	return cert, nil
 }
 
-type CertHandler struct {
-	DB *MonDB
-}
-
-func (s *CertHandler) HandleEvents(ch chan models.MonEvent) {
-	for {
-		ev := <-ch
-		entry := *ev.LogEntry
-		switch ev.Type {
-		case models.CT_CERT:
-			pemCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: entry.X509Cert.Raw})
-			hasher := sha256.New()
-			hasher.Write(entry.X509Cert.Raw)
-			sha := hex.EncodeToString(hasher.Sum(nil))
-			c := &CertInfo{Index: entry.Index, CommonName: entry.X509Cert.Subject.CommonName,
-				Issuer:    entry.X509Cert.Issuer.CommonName,
-				Serial:    entry.X509Cert.SerialNumber.String(),
-				NotBefore: entry.X509Cert.NotBefore, NotAfter: entry.X509Cert.NotAfter,
-				KeyUsage:           int(entry.X509Cert.KeyUsage),
-				PublicKeyAlgorithm: int(entry.X509Cert.PublicKeyAlgorithm),
-				SignatureAlgorithm: int(entry.X509Cert.SignatureAlgorithm),
-				DNSNames:           entry.X509Cert.DNSNames, EmailAddresses: entry.X509Cert.EmailAddresses,
-				OCSPServer:            entry.X509Cert.OCSPServer,
-				IssuingCertificateURL: entry.X509Cert.IssuingCertificateURL,
-				PEMCert:               string(pemCert), Precert: false, SHA256Sum: sha}
-			s.DB.StoreCertDetails(c)
-		case models.CT_PRECERT:
-			pemCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: entry.Precert.TBSCertificate.Raw})
-			hasher := sha256.New()
-			hasher.Write(entry.Precert.TBSCertificate.Raw)
-			sha := hex.EncodeToString(hasher.Sum(nil))
-			c := &CertInfo{Index: entry.Index, CommonName: entry.Precert.TBSCertificate.Subject.CommonName,
-				Issuer:                entry.Precert.TBSCertificate.Issuer.CommonName,
-				Serial:                entry.Precert.TBSCertificate.SerialNumber.String(),
-				NotBefore:             entry.Precert.TBSCertificate.NotBefore,
-				NotAfter:              entry.Precert.TBSCertificate.NotAfter,
-				KeyUsage:              int(entry.Precert.TBSCertificate.KeyUsage),
-				PublicKeyAlgorithm:    int(entry.Precert.TBSCertificate.PublicKeyAlgorithm),
-				SignatureAlgorithm:    int(entry.Precert.TBSCertificate.SignatureAlgorithm),
-				DNSNames:              entry.Precert.TBSCertificate.DNSNames,
-				EmailAddresses:        entry.Precert.TBSCertificate.EmailAddresses,
-				OCSPServer:            entry.Precert.TBSCertificate.OCSPServer,
-				IssuingCertificateURL: entry.Precert.TBSCertificate.IssuingCertificateURL,
-				PEMCert:               string(pemCert), Precert: true, SHA256Sum: sha}
-			s.DB.StoreCertDetails(c)
-		case models.CT_QUIT:
-			break
+func (m *MonDB) FindCerts(q CertQuery) ([]CertInfo, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return nil, err
+	}
+	defer session.Close()
+
+	filter := bson.M{}
+	if r := timeRange(q.NotAfterAfter, q.NotAfterBefore); r != nil {
+		filter["NotAfter"] = r
+	}
+	if r := timeRange(q.CreatedAfter, q.CreatedBefore); r != nil {
+		filter["created"] = r
+	}
+	if q.Precert != nil {
+		filter["precert"] = *q.Precert
+	}
+	if q.Name != "" {
+		filter["names"] = strings.ToLower(q.Name)
+	} else if q.NameSuffix != "" {
+		filter["names"] = bson.RegEx{Pattern: regexp.QuoteMeta(strings.ToLower(q.NameSuffix)) + "$"}
+	}
+	if q.Issuer != "" {
+		filter["Issuer"] = q.Issuer
+	}
+	if q.Fingerprint != "" {
+		filter["fingerprint"] = q.Fingerprint
+	}
+	if q.Rule != "" {
+		filter["rules"] = q.Rule
+	}
+	if q.Status != "" {
+		filter["status"] = q.Status
+	}
+	if q.Assignee != "" {
+		filter["assignee"] = q.Assignee
+	}
+	if q.Suppressed != nil {
+		/* documents stored before suppressions have no flag */
+		if *q.Suppressed {
+			filter["suppressed"] = true
+		} else {
+			filter["suppressed"] = bson.M{"$ne": true}
 		}
 	}
+
+	sort := "created"
+	if q.Desc {
+		sort = "-created"
+	}
+	query := session.DB("").C("certificate_details").Find(filter).Sort(sort)
+	if q.Offset > 0 {
+		query = query.Skip(q.Offset)
+	}
+	if q.Limit > 0 {
+		query = query.Limit(q.Limit)
+	}
+	var result []CertInfo
+	err = query.All(&result)
+	return result, err
+}
+
+/* attempts to apply triage update while other analysts change the same certificate */
+const triageRetries = 10
+
+var errTriageConflict = errors.New("certificate triage is changed concurrently, try again")
+
+/* missing fields of older documents are read as empty values */
+func orMissing(v string) interface{} {
+	if v == "" {
+		return bson.M{"$in": []interface{}{"", nil}}
+	}
+	return v
+}
+
+// UpdateTriage applies |u| to stored certificate |fingerprint|, returns updated certificate and changes made.
+func (m *MonDB) UpdateTriage(fingerprint string, u *TriageUpdate) (*CertInfo, []models.TriageChange, error) {
+	for i := 0; i < triageRetries; i++ {
+		cert, err := m.FindCert(fingerprint)
+		if err != nil {
+			return nil, nil, err
+		}
+		/* updated only if triage is the same as read */
+		filter := bson.M{"fingerprint": fingerprint, "status": orMissing(string(cert.Status)),
+			"assignee": orMissing(cert.Assignee)}
+		changes := u.Apply(cert, time.Now().UTC())
+		if len(changes) == 0 {
+			return cert, nil, nil
+		}
+		err = m.updateCertIf(filter, bson.M{"$set": bson.M{"status": cert.Status, "assignee": cert.Assignee},
+			"$push": bson.M{"history": bson.M{"$each": changes}}})
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return cert, changes, nil
+	}
+	return nil, nil, errTriageConflict
+}
+
+// SetSuppressed changes suppressed flag of stored certificate |fingerprint|.
+func (m *MonDB) SetSuppressed(fingerprint string, suppressed bool) error {
+	return m.updateCert(fingerprint, bson.M{"$set": bson.M{"suppressed": suppressed}})
+}
+
+func (m *MonDB) updateCert(fingerprint string, change bson.M) error {
+	return m.updateCertIf(bson.M{"fingerprint": fingerprint}, change)
+}
+
+func (m *MonDB) updateCertIf(filter bson.M, change bson.M) error {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return err
+	}
+	defer session.Close()
+
+	return mgoNotFound(session.DB("").C("certificate_details").Update(filter, change))
+}
+
+func timeRange(after time.Time, before time.Time) bson.M {
+	r := bson.M{}
+	if !after.IsZero() {
+		r["$gte"] = after
+	}
+	if !before.IsZero() {
+		r["$lt"] = before
+	}
+	if len(r) == 0 {
+		return nil
+	}
+	return r
+}
+
+func (m *MonDB) DeleteCerts(fingerprints []string) (int, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return 0, err
+	}
+	defer session.Close()
+
+	info, err := session.DB("").C("certificate_details").RemoveAll(bson.M{"fingerprint": bson.M{"$in": fingerprints}})
+	if err != nil {
+		return 0, err
+	}
+	return info.Removed, nil
+}
+
+// FindCertByIndex returns certificate seen at |index| of |logUri|, any log if |logUri| is empty.
+func (m *MonDB) FindCertByIndex(logUri string, index int64) (*CertInfo, error) {
+	fingerprint, err := m.FindFingerprint(logUri, index)
+	if err != nil {
+		return nil, err
+	}
+	return m.FindCert(fingerprint)
+}
+
+type CertHandler struct {
+	DB Store
+}
+
+/* exact log entry data for incident response */
+func setRawEntry(c *CertInfo, m *models.Match) {
+	c.LeafInput = certs.LeafInput(m.Entry)
+	c.ExtraData = certs.ExtraData(m.Entry)
+	c.Chain = certs.Chain(m.Entry)
+	c.Timestamp = m.Timestamp
+	c.LogID = m.LogID
+}
+
+func (s *CertHandler) Notify(m *models.Match) error {
+	cert := certs.Leaf(m.Entry)
+	c := &CertInfo{Fingerprint: m.Fingerprint, Log: m.Log,
+		Index: m.Index, CommonName: m.CommonName,
+		Issuer:    m.Issuer,
+		Serial:    m.Serial,
+		NotBefore: m.NotBefore, NotAfter: m.NotAfter,
+		KeyUsage:           int(cert.KeyUsage),
+		PublicKeyAlgorithm: int(cert.PublicKeyAlgorithm),
+		SignatureAlgorithm: int(cert.SignatureAlgorithm),
+		DNSNames:           m.DNSNames, EmailAddresses: cert.EmailAddresses,
+		OCSPServer:            cert.OCSPServer,
+		IssuingCertificateURL: cert.IssuingCertificateURL,
+		PEMCert:               m.PEM, Precert: m.Precert, SHA256Sum: m.Fingerprint,
+		Lints:   lint.Check(cert),
+		TBSHash: m.TBSHash, PrecertSeen: m.FinalForPrecert,
+		Suppressed: m.Suppressed, Rules: m.Rules, Severity: string(m.Severity)}
+	setRawEntry(c, m)
+	return s.DB.StoreCertDetails(c)
+}
+
+func (s *CertHandler) Close() error {
+	return nil
 }
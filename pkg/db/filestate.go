@@ -0,0 +1,112 @@
+package db
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// StateStore keeps scan position of every log.
+type StateStore interface {
+	LoadState(logUri string) (int64, error)
+	SaveState(logUri string, startIndex int64) error
+}
+
+type fileLogState struct {
+	StartIndex int64     `json:"start_index"`
+	Updated    time.Time `json:"updated"`
+}
+
+// FileState keeps monitor state in local JSON file for deployments without DB.
+type FileState struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileState(path string) *FileState {
+	return &FileState{path: path}
+}
+
+func (f *FileState) load() (map[string]fileLogState, error) {
+	state := make(map[string]fileLogState)
+	data, err := ioutil.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (f *FileState) LoadState(logUri string) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	state, err := f.load()
+	if err != nil {
+		return 0, err
+	}
+	s, ok := state[logUri]
+	if !ok {
+		return 0, ErrNotFound
+	}
+	return s.StartIndex, nil
+}
+
+func (f *FileState) SaveState(logUri string, startIndex int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	state, err := f.load()
+	if err != nil {
+		return err
+	}
+	state[logUri] = fileLogState{StartIndex: startIndex, Updated: time.Now().UTC()}
+	data, err := json.MarshalIndent(state, "", " ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(f.path, data)
+}
+
+/* write to temporary file, fsync and rename over the old one, so crash never leaves half-written state */
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	tmp, err := ioutil.TempFile(dir, filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	/* persist rename itself */
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -0,0 +1,110 @@
+package db
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func tempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "ct_mon_state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+/* names of files in |dir| */
+func files(t *testing.T, dir string) []string {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	return names
+}
+
+func TestFileState(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := filepath.Join(dir, "state.json")
+
+	f := NewFileState(path)
+	if _, err := f.LoadState("https://a.example.com/"); err != ErrNotFound {
+		t.Errorf("LoadState without file: %v, want ErrNotFound", err)
+	}
+	for _, s := range []struct {
+		uri   string
+		index int64
+	}{{"https://a.example.com/", 10}, {"https://b.example.com/", 20}, {"https://a.example.com/", 11}} {
+		if err := f.SaveState(s.uri, s.index); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	/* state survives restart */
+	f = NewFileState(path)
+	for uri, want := range map[string]int64{"https://a.example.com/": 11, "https://b.example.com/": 20} {
+		if index, err := f.LoadState(uri); err != nil || index != want {
+			t.Errorf("LoadState %s: %d (%v), want %d", uri, index, err, want)
+		}
+	}
+	if got := files(t, dir); len(got) != 1 || got[0] != "state.json" {
+		t.Errorf("files %v, want only state.json", got)
+	}
+
+	/* corrupted state is an error, not a scan from the start */
+	if err := ioutil.WriteFile(path, []byte(`{"https://a.example.com/": {"start_ind`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.LoadState("https://a.example.com/"); err == nil {
+		t.Error("no error for corrupted state")
+	}
+	if err := f.SaveState("https://a.example.com/", 12); err == nil {
+		t.Error("corrupted state is overwritten")
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := filepath.Join(dir, "state.json")
+
+	for _, data := range []string{`{"a": 1}`, `{"b": 2}`} {
+		if err := writeFileAtomic(path, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadFile(path)
+		if err != nil || string(got) != data {
+			t.Errorf("file %q (%v), want %q", got, err, data)
+		}
+		var v map[string]int
+		if err := json.Unmarshal(got, &v); err != nil {
+			t.Errorf("invalid file (%v)", err)
+		}
+	}
+
+	/* failed rename leaves neither temporary file nor changes */
+	target := filepath.Join(dir, "busy")
+	if err := os.Mkdir(target, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeFileAtomic(target, []byte(`{}`)); err == nil {
+		t.Error("file written over directory")
+	}
+	if info, err := os.Stat(target); err != nil || !info.IsDir() {
+		t.Errorf("directory is replaced (%v)", err)
+	}
+	if got := files(t, dir); len(got) != 2 {
+		t.Errorf("files %v, want busy and state.json", got)
+	}
+
+	if err := writeFileAtomic(filepath.Join(dir, "missing", "state.json"), []byte(`{}`)); err == nil {
+		t.Error("no error for missing directory")
+	}
+}
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"log"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const (
+	OutboxPending   = "pending"
+	OutboxDelivered = "delivered"
+	OutboxFailed    = "failed"
+)
+
+// Notification is match waiting for delivery to sink, |Payload| is JSON match record.
+type Notification struct {
+	Id          bson.ObjectId `json:"id,omitempty" bson:"_id"`
+	Sink        string        `bson:"sink"`
+	Payload     []byte        `bson:"payload"`
+	Status      string        `bson:"status"`
+	Attempts    int           `bson:"attempts"`
+	NextAttempt time.Time     `bson:"next_attempt"`
+	LastError   string        `bson:"last_error"`
+	Created     time.Time     `bson:"created"`
+	Updated     time.Time     `bson:"updated"`
+}
+
+func outboxIndexes(db *mgo.Database) error {
+	return db.C("outbox").EnsureIndex(mgo.Index{Key: []string{"status", "next_attempt"}})
+}
+
+func (m *MonDB) EnqueueNotification(n *Notification) error {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return err
+	}
+	defer session.Close()
+
+	n.Id = bson.NewObjectId()
+	n.Status = OutboxPending
+	n.Created = time.Now().UTC()
+	n.Updated = n.Created
+	return session.DB("").C("outbox").Insert(n)
+}
+
+func (m *MonDB) UpdateNotification(n *Notification) error {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return err
+	}
+	defer session.Close()
+
+	n.Updated = time.Now().UTC()
+	return mgoNotFound(session.DB("").C("outbox").UpdateId(n.Id, bson.M{"$set": bson.M{"status": n.Status,
+		"attempts": n.Attempts, "next_attempt": n.NextAttempt, "last_error": n.LastError, "updated": n.Updated}}))
+}
+
+// DueNotifications returns pending notifications with next attempt before |now|, oldest first.
+func (m *MonDB) DueNotifications(now time.Time, limit int) ([]Notification, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return nil, err
+	}
+	defer session.Close()
+
+	var result []Notification
+	err = session.DB("").C("outbox").Find(bson.M{"status": OutboxPending, "next_attempt": bson.M{"$lte": now}}).
+		Sort("next_attempt").Limit(limit).All(&result)
+	return result, err
+}
+
+// PurgeNotifications removes delivered and failed notifications updated before |before|.
+func (m *MonDB) PurgeNotifications(before time.Time) (int, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return 0, err
+	}
+	defer session.Close()
+
+	info, err := session.DB("").C("outbox").RemoveAll(bson.M{"status": bson.M{"$ne": OutboxPending},
+		"updated": bson.M{"$lt": before}})
+	if err != nil {
+		return 0, err
+	}
+	return info.Removed, nil
+}
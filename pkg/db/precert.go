@@ -0,0 +1,92 @@
+package db
+
+import (
+	"log"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+type PrecertInfo struct {
+	Id         bson.ObjectId `json:"id,omitempty" bson:"_id"`
+	TBSHash    string        `bson:"tbs_hash"`
+	Log        string        `bson:"log"`
+	Index      int64         `bson:"index"`
+	CommonName string        `bson:"CommonName"`
+	Issuer     string        `bson:"Issuer"`
+	DNSNames   []string      `bson:"DNSNames"`
+	Created    time.Time     `bson:"created"`
+	Linked     bool          `bson:"linked"`
+	CertLog    string        `bson:"cert_log,omitempty"`
+	CertIndex  int64         `bson:"cert_index,omitempty"`
+	CertSeen   time.Time     `bson:"cert_seen,omitempty"`
+}
+
+func (m *MonDB) StorePrecert(p *PrecertInfo) error {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return err
+	}
+	defer session.Close()
+
+	col := session.DB("").C("precerts")
+	/* same precert can be submitted to several logs */
+	p.Id = bson.NewObjectId()
+	p.Created = time.Now().UTC()
+	return upsert(col, bson.M{"tbs_hash": p.TBSHash}, bson.M{"$setOnInsert": p})
+}
+
+// LinkPrecert marks precert with |tbsHash| as followed by certificate at |certIndex| of |certLog|,
+// returns false if no such precert was seen or it is linked already.
+func (m *MonDB) LinkPrecert(tbsHash string, certLog string, certIndex int64) (bool, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return false, err
+	}
+	defer session.Close()
+
+	col := session.DB("").C("precerts")
+	change := bson.M{"$set": bson.M{"linked": true, "cert_log": certLog, "cert_index": certIndex, "cert_seen": time.Now().UTC()}}
+	err = col.Update(bson.M{"tbs_hash": tbsHash, "linked": false}, change)
+	if err == mgo.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UnlinkedPrecerts returns precerts seen before |before| never followed by a certificate.
+func (m *MonDB) UnlinkedPrecerts(before time.Time) ([]PrecertInfo, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return nil, err
+	}
+	defer session.Close()
+
+	col := session.DB("").C("precerts")
+	var result []PrecertInfo
+	err = col.Find(bson.M{"linked": false, "created": bson.M{"$lt": before}}).Sort("created").All(&result)
+	return result, err
+}
+
+// PurgePrecerts removes precerts seen before |before|, linked or not.
+func (m *MonDB) PurgePrecerts(before time.Time) (int, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return 0, err
+	}
+	defer session.Close()
+
+	info, err := session.DB("").C("precerts").RemoveAll(bson.M{"created": bson.M{"$lt": before}})
+	if err != nil {
+		return 0, err
+	}
+	return info.Removed, nil
+}
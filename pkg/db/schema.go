@@ -0,0 +1,190 @@
+package db
+
+import (
+	"log"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+type schemaInfo struct {
+	Id      string    `bson:"_id"`
+	Version int       `bson:"version"`
+	Updated time.Time `bson:"updated"`
+}
+
+type migration struct {
+	version     int
+	description string
+	apply       func(db *mgo.Database) error
+}
+
+/* append only, applied in order on startup */
+var migrations = []migration{
+	{1, "fingerprint for certificates stored before dedup", backfillFingerprints},
+	{2, "indexes", ensureIndexes},
+	{3, "outbox indexes", outboxIndexes},
+	{4, "rule and precert indexes", queryIndexes},
+	{5, "triage status", triageStatus},
+	{6, "lowercase names", lowercaseNames},
+}
+
+func SchemaVersion() int {
+	return migrations[len(migrations)-1].version
+}
+
+func (m *MonDB) loadSchemaVersion(db *mgo.Database) (int, error) {
+	info := schemaInfo{}
+	err := db.C("schema").FindId("ct_mon").One(&info)
+	if err == mgo.ErrNotFound {
+		return 0, nil
+	}
+	return info.Version, err
+}
+
+// Migrate brings DB schema to the current version.
+func (m *MonDB) Migrate() error {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return err
+	}
+	defer session.Close()
+	db := session.DB("")
+
+	version, err := m.loadSchemaVersion(db)
+	if err != nil {
+		return err
+	}
+	for _, mig := range migrations {
+		if mig.version <= version {
+			continue
+		}
+		log.Printf("Migrating DB schema to version %d: %s", mig.version, mig.description)
+		if err := mig.apply(db); err != nil {
+			return err
+		}
+		_, err = db.C("schema").UpsertId("ct_mon", bson.M{"$set": bson.M{"version": mig.version, "updated": time.Now().UTC()}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func backfillFingerprints(db *mgo.Database) error {
+	col := db.C("certificate_details")
+	seen := make(map[string]bool)
+	iter := col.Find(bson.M{"fingerprint": bson.M{"$exists": false}}).Iter()
+	cert := CertInfo{}
+	for iter.Next(&cert) {
+		/* same certificate was stored under several indexes */
+		if seen[cert.SHA256Sum] {
+			if err := col.RemoveId(cert.Id); err != nil {
+				return err
+			}
+			continue
+		}
+		seen[cert.SHA256Sum] = true
+		if err := col.UpdateId(cert.Id, bson.M{"$set": bson.M{"fingerprint": cert.SHA256Sum}}); err != nil {
+			return err
+		}
+	}
+	return iter.Close()
+}
+
+func ensureIndexes(db *mgo.Database) error {
+	indexes := map[string][]mgo.Index{
+		"certificate_details": {
+			{Key: []string{"fingerprint"}, Unique: true},
+			{Key: []string{"DNSNames"}},
+			{Key: []string{"CommonName"}},
+			{Key: []string{"Issuer"}},
+			{Key: []string{"NotAfter"}},
+			{Key: []string{"created"}},
+		},
+		"sightings": {
+			{Key: []string{"fingerprint"}, Unique: true},
+			{Key: []string{"sightings.log", "sightings.index"}},
+		},
+		"precerts": {
+			{Key: []string{"tbs_hash"}, Unique: true},
+			{Key: []string{"linked", "created"}},
+		},
+		"state": {
+			{Key: []string{"log_uri"}},
+		},
+	}
+	for name, idx := range indexes {
+		for _, i := range idx {
+			if err := db.C(name).EnsureIndex(i); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func queryIndexes(db *mgo.Database) error {
+	col := db.C("certificate_details")
+	for _, key := range []string{"rules", "precert"} {
+		if err := col.EnsureIndex(mgo.Index{Key: []string{key}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/* dashboard kept acknowledged time only before triage status */
+func triageStatus(db *mgo.Database) error {
+	col := db.C("certificate_details")
+	var old struct {
+		Id             bson.ObjectId `bson:"_id"`
+		Acknowledged   time.Time     `bson:"acknowledged"`
+		AcknowledgedBy string        `bson:"acknowledged_by"`
+	}
+	iter := col.Find(bson.M{"acknowledged": bson.M{"$exists": true}}).Iter()
+	for iter.Next(&old) {
+		history := []models.TriageChange{{Time: old.Acknowledged, By: old.AcknowledgedBy,
+			Field: models.TriageFieldStatus, From: string(models.TriageNew), To: string(models.TriageAcknowledged)}}
+		err := col.UpdateId(old.Id, bson.M{"$set": bson.M{"status": models.TriageAcknowledged, "history": history},
+			"$unset": bson.M{"acknowledged": "", "acknowledged_by": ""}})
+		if err != nil {
+			iter.Close()
+			return err
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	if _, err := col.UpdateAll(bson.M{"status": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"status": models.TriageNew}}); err != nil {
+		return err
+	}
+	for _, key := range []string{"status", "assignee"} {
+		if err := col.EnsureIndex(mgo.Index{Key: []string{key}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/* names are looked up in lowercase */
+func lowercaseNames(db *mgo.Database) error {
+	col := db.C("certificate_details")
+	var cert CertInfo
+	iter := col.Find(bson.M{"names": bson.M{"$exists": false}}).Select(bson.M{"CommonName": 1, "DNSNames": 1}).Iter()
+	for iter.Next(&cert) {
+		if err := col.UpdateId(cert.Id, bson.M{"$set": bson.M{"names": lowerNames(&cert)}}); err != nil {
+			iter.Close()
+			return err
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	return col.EnsureIndex(mgo.Index{Key: []string{"names"}})
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"log"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+type Sighting struct {
+	Log       string    `bson:"log"`
+	Index     int64     `bson:"index"`
+	Timestamp time.Time `bson:"timestamp"`
+}
+
+type SightingInfo struct {
+	Id          bson.ObjectId `json:"id,omitempty" bson:"_id"`
+	Fingerprint string        `bson:"fingerprint"`
+	Precert     bool          `bson:"precert"`
+	Sightings   []Sighting    `bson:"sightings"`
+	Created     time.Time     `bson:"created"`
+	Updated     time.Time     `bson:"updated"`
+}
+
+// AddSighting records certificate |fingerprint| seen in log, returns true on the first sighting.
+func (m *MonDB) AddSighting(fingerprint string, precert bool, s Sighting) (bool, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return false, err
+	}
+	defer session.Close()
+
+	col := session.DB("").C("sightings")
+	/* rescans see the same entries again */
+	now := time.Now().UTC()
+	change := bson.M{"$addToSet": bson.M{"sightings": s}, "$set": bson.M{"updated": now},
+		"$setOnInsert": bson.M{"precert": precert, "created": now}}
+	info, err := upsertInfo(col, bson.M{"fingerprint": fingerprint}, change)
+	if err != nil {
+		return false, err
+	}
+	return info.UpsertedId != nil, nil
+}
+
+func (m *MonDB) FindSightings(fingerprint string) (*SightingInfo, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return nil, err
+	}
+	defer session.Close()
+
+	col := session.DB("").C("sightings")
+	info := &SightingInfo{}
+	err = col.Find(bson.M{"fingerprint": fingerprint}).One(info)
+	if err != nil {
+		return nil, mgoNotFound(err)
+	}
+	return info, nil
+}
+
+// FindFingerprint returns fingerprint of certificate seen at |index| of |logUri|, any log if |logUri| is empty.
+func (m *MonDB) FindFingerprint(logUri string, index int64) (string, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return "", err
+	}
+	defer session.Close()
+
+	col := session.DB("").C("sightings")
+	match := bson.M{"index": index}
+	if logUri != "" {
+		match["log"] = logUri
+	}
+	info := &SightingInfo{}
+	err = col.Find(bson.M{"sightings": bson.M{"$elemMatch": match}}).One(info)
+	if err != nil {
+		return "", mgoNotFound(err)
+	}
+	return info.Fingerprint, nil
+}
+
+// PurgeSightings removes certificates last seen before |before| with all their sightings.
+func (m *MonDB) PurgeSightings(before time.Time) (int, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return 0, err
+	}
+	defer session.Close()
+
+	info, err := session.DB("").C("sightings").RemoveAll(bson.M{"updated": bson.M{"$lt": before}})
+	if err != nil {
+		return 0, err
+	}
+	return info.Removed, nil
+}
@@ -0,0 +1,819 @@
+package db
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+// SQLDB is SQLite and PostgreSQL storage backend, certificate details are kept as JSON.
+type SQLDB struct {
+	driver string
+	db     *sql.DB
+}
+
+type sqlMigration struct {
+	version     int
+	description string
+	statements  []string
+	/* data migration run after statements in the same transaction */
+	apply func(m *SQLDB, tx *sql.Tx) error
+}
+
+/* append only, statements must work on both SQLite and PostgreSQL */
+var sqlMigrations = []sqlMigration{
+	{1, "initial schema", []string{
+		`CREATE TABLE IF NOT EXISTS state (
+			log_uri TEXT PRIMARY KEY,
+			start_index BIGINT NOT NULL,
+			created TIMESTAMP NOT NULL,
+			updated TIMESTAMP NOT NULL)`,
+		`CREATE TABLE IF NOT EXISTS certificate_details (
+			fingerprint TEXT PRIMARY KEY,
+			common_name TEXT NOT NULL,
+			issuer TEXT NOT NULL,
+			not_after TIMESTAMP NOT NULL,
+			precert BOOLEAN NOT NULL,
+			created TIMESTAMP NOT NULL,
+			data TEXT NOT NULL)`,
+		`CREATE INDEX IF NOT EXISTS certificate_details_issuer ON certificate_details (issuer)`,
+		`CREATE INDEX IF NOT EXISTS certificate_details_not_after ON certificate_details (not_after)`,
+		`CREATE INDEX IF NOT EXISTS certificate_details_created ON certificate_details (created)`,
+		`CREATE TABLE IF NOT EXISTS certificate_names (
+			fingerprint TEXT NOT NULL,
+			name TEXT NOT NULL,
+			PRIMARY KEY (fingerprint, name))`,
+		`CREATE INDEX IF NOT EXISTS certificate_names_name ON certificate_names (name)`,
+		`CREATE TABLE IF NOT EXISTS fingerprints (
+			fingerprint TEXT PRIMARY KEY,
+			precert BOOLEAN NOT NULL,
+			created TIMESTAMP NOT NULL,
+			updated TIMESTAMP NOT NULL)`,
+		`CREATE TABLE IF NOT EXISTS sightings (
+			fingerprint TEXT NOT NULL,
+			log_uri TEXT NOT NULL,
+			log_index BIGINT NOT NULL,
+			timestamp TIMESTAMP NOT NULL,
+			PRIMARY KEY (fingerprint, log_uri, log_index))`,
+		`CREATE INDEX IF NOT EXISTS sightings_log_index ON sightings (log_uri, log_index)`,
+		`CREATE TABLE IF NOT EXISTS precerts (
+			tbs_hash TEXT PRIMARY KEY,
+			linked BOOLEAN NOT NULL,
+			cert_log TEXT NOT NULL DEFAULT '',
+			cert_index BIGINT NOT NULL DEFAULT 0,
+			cert_seen TIMESTAMP,
+			created TIMESTAMP NOT NULL,
+			data TEXT NOT NULL)`,
+		`CREATE INDEX IF NOT EXISTS precerts_linked_created ON precerts (linked, created)`,
+		`CREATE TABLE IF NOT EXISTS suppressions (
+			id TEXT PRIMARY KEY,
+			fingerprint TEXT NOT NULL DEFAULT '',
+			name TEXT NOT NULL DEFAULT '',
+			reason TEXT NOT NULL DEFAULT '',
+			created TIMESTAMP NOT NULL)`,
+	}, nil},
+	{2, "suppressed flag", []string{
+		`ALTER TABLE certificate_details ADD COLUMN suppressed BOOLEAN NOT NULL DEFAULT FALSE`,
+	}, nil},
+	{3, "outbox", []string{
+		`CREATE TABLE IF NOT EXISTS outbox (
+			id TEXT PRIMARY KEY,
+			sink TEXT NOT NULL,
+			payload TEXT NOT NULL,
+			status TEXT NOT NULL,
+			attempts INTEGER NOT NULL,
+			next_attempt TIMESTAMP NOT NULL,
+			last_error TEXT NOT NULL DEFAULT '',
+			created TIMESTAMP NOT NULL,
+			updated TIMESTAMP NOT NULL)`,
+		`CREATE INDEX IF NOT EXISTS outbox_status_next_attempt ON outbox (status, next_attempt)`,
+	}, nil},
+	{4, "certificate rules", []string{
+		`CREATE TABLE IF NOT EXISTS certificate_rules (
+			fingerprint TEXT NOT NULL,
+			rule TEXT NOT NULL,
+			PRIMARY KEY (fingerprint, rule))`,
+		`CREATE INDEX IF NOT EXISTS certificate_rules_rule ON certificate_rules (rule)`,
+	}, backfillRules},
+	{5, "triage status", []string{
+		`ALTER TABLE certificate_details ADD COLUMN status TEXT NOT NULL DEFAULT 'new'`,
+		`ALTER TABLE certificate_details ADD COLUMN assignee TEXT NOT NULL DEFAULT ''`,
+		`CREATE INDEX IF NOT EXISTS certificate_details_status ON certificate_details (status)`,
+		`CREATE INDEX IF NOT EXISTS certificate_details_assignee ON certificate_details (assignee)`,
+	}, backfillTriage},
+	{6, "lowercase names", []string{
+		`INSERT INTO certificate_names (fingerprint, name)
+			SELECT fingerprint, LOWER(name) FROM certificate_names WHERE name <> LOWER(name)
+			ON CONFLICT (fingerprint, name) DO NOTHING`,
+		`DELETE FROM certificate_names WHERE name <> LOWER(name)`,
+	}, nil},
+}
+
+func InitSQL(driver string, uri string) (*SQLDB, error) {
+	db, err := sql.Open(driver, uri)
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return nil, err
+	}
+	if err = db.Ping(); err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return nil, err
+	}
+	if driver == "sqlite3" {
+		/* SQLite allows single writer only */
+		db.SetMaxOpenConns(1)
+	}
+
+	m := &SQLDB{driver: driver, db: db}
+	if err := m.Migrate(); err != nil {
+		log.Printf("DB schema migration error (%v)\n", err)
+		db.Close()
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *SQLDB) Close() error {
+	return m.db.Close()
+}
+
+// Ping checks DB is reachable.
+func (m *SQLDB) Ping() error {
+	return m.db.Ping()
+}
+
+/* queries are written with ? placeholders, PostgreSQL wants $N */
+func (m *SQLDB) rebind(query string) string {
+	if m.driver != "postgres" {
+		return query
+	}
+	var buf bytes.Buffer
+	n := 0
+	for _, ch := range query {
+		if ch == '?' {
+			n++
+			fmt.Fprintf(&buf, "$%d", n)
+			continue
+		}
+		buf.WriteRune(ch)
+	}
+	return buf.String()
+}
+
+func (m *SQLDB) exec(query string, args ...interface{}) (sql.Result, error) {
+	return m.db.Exec(m.rebind(query), args...)
+}
+
+func (m *SQLDB) queryRow(query string, args ...interface{}) *sql.Row {
+	return m.db.QueryRow(m.rebind(query), args...)
+}
+
+func (m *SQLDB) query(query string, args ...interface{}) (*sql.Rows, error) {
+	return m.db.Query(m.rebind(query), args...)
+}
+
+func notFound(err error) error {
+	if err == sql.ErrNoRows {
+		return ErrNotFound
+	}
+	return err
+}
+
+// Migrate brings DB schema to the current version.
+func (m *SQLDB) Migrate() error {
+	_, err := m.exec(`CREATE TABLE IF NOT EXISTS schema_version (
+		id INTEGER PRIMARY KEY,
+		version INTEGER NOT NULL,
+		updated TIMESTAMP NOT NULL)`)
+	if err != nil {
+		return err
+	}
+
+	var version int
+	err = m.queryRow(`SELECT version FROM schema_version WHERE id = 1`).Scan(&version)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	for _, mig := range sqlMigrations {
+		if mig.version <= version {
+			continue
+		}
+		log.Printf("Migrating DB schema to version %d: %s", mig.version, mig.description)
+		tx, err := m.db.Begin()
+		if err != nil {
+			return err
+		}
+		for _, stmt := range mig.statements {
+			if _, err := tx.Exec(stmt); err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+		if mig.apply != nil {
+			if err := mig.apply(m, tx); err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+		_, err = tx.Exec(m.rebind(`INSERT INTO schema_version (id, version, updated) VALUES (1, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET version = excluded.version, updated = excluded.updated`),
+			mig.version, time.Now().UTC())
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *SQLDB) LoadState(logUri string) (int64, error) {
+	/* there is no state saved before multiple logs support */
+	if logUri == "" {
+		return 0, ErrNotFound
+	}
+	var startIndex int64
+	err := m.queryRow(`SELECT start_index FROM state WHERE log_uri = ?`, logUri).Scan(&startIndex)
+	return startIndex, notFound(err)
+}
+
+func (m *SQLDB) SaveState(logUri string, startIndex int64) error {
+	now := time.Now().UTC()
+	_, err := m.exec(`INSERT INTO state (log_uri, start_index, created, updated) VALUES (?, ?, ?, ?)
+		ON CONFLICT (log_uri) DO UPDATE SET start_index = excluded.start_index, updated = excluded.updated`,
+		logUri, startIndex, now, now)
+	return err
+}
+
+func (m *SQLDB) StoreCertDetails(cert *CertInfo) error {
+	cert.Created = time.Now().UTC()
+	if cert.Status == "" {
+		cert.Status = models.TriageNew
+	}
+	data, err := json.Marshal(cert)
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+	/* do not store same certificate more than once, it can be seen in several logs */
+	res, err := tx.Exec(m.rebind(`INSERT INTO certificate_details
+		(fingerprint, common_name, issuer, not_after, precert, suppressed, status, assignee, created, data)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (fingerprint) DO NOTHING`),
+		cert.Fingerprint, cert.CommonName, cert.Issuer, cert.NotAfter.UTC(), cert.Precert, cert.Suppressed,
+		cert.Status, cert.Assignee, cert.Created, string(data))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		for _, name := range lowerNames(cert) {
+			_, err = tx.Exec(m.rebind(`INSERT INTO certificate_names (fingerprint, name) VALUES (?, ?)
+				ON CONFLICT (fingerprint, name) DO NOTHING`), cert.Fingerprint, name)
+			if err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+		if err := m.insertRules(tx, cert.Fingerprint, cert.Rules); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (m *SQLDB) insertRules(tx *sql.Tx, fingerprint string, rules []string) error {
+	for _, rule := range rules {
+		_, err := tx.Exec(m.rebind(`INSERT INTO certificate_rules (fingerprint, rule) VALUES (?, ?)
+			ON CONFLICT (fingerprint, rule) DO NOTHING`), fingerprint, rule)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/* rules of certificates stored before were kept in data only */
+func backfillRules(m *SQLDB, tx *sql.Tx) error {
+	rows, err := tx.Query(`SELECT data FROM certificate_details`)
+	if err != nil {
+		return err
+	}
+	var stored []CertInfo
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			rows.Close()
+			return err
+		}
+		var cert CertInfo
+		if err := json.Unmarshal([]byte(data), &cert); err != nil {
+			rows.Close()
+			return err
+		}
+		if len(cert.Rules) > 0 {
+			stored = append(stored, CertInfo{Fingerprint: cert.Fingerprint, Rules: cert.Rules})
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, cert := range stored {
+		if err := m.insertRules(tx, cert.Fingerprint, cert.Rules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/* dashboard kept acknowledged time only before triage status */
+func backfillTriage(m *SQLDB, tx *sql.Tx) error {
+	rows, err := tx.Query(`SELECT data FROM certificate_details`)
+	if err != nil {
+		return err
+	}
+	var stored []CertInfo
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			rows.Close()
+			return err
+		}
+		var cert CertInfo
+		var old struct {
+			Acknowledged   time.Time
+			AcknowledgedBy string
+		}
+		if err := json.Unmarshal([]byte(data), &cert); err != nil {
+			rows.Close()
+			return err
+		}
+		json.Unmarshal([]byte(data), &old)
+		if cert.Status != "" {
+			continue
+		}
+		cert.Status = models.TriageNew
+		if !old.Acknowledged.IsZero() {
+			cert.Status = models.TriageAcknowledged
+			cert.History = []models.TriageChange{{Time: old.Acknowledged, By: old.AcknowledgedBy,
+				Field: models.TriageFieldStatus, From: string(models.TriageNew), To: string(models.TriageAcknowledged)}}
+		}
+		stored = append(stored, cert)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, cert := range stored {
+		data, err := json.Marshal(&cert)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(m.rebind(`UPDATE certificate_details SET status = ?, data = ? WHERE fingerprint = ?`),
+			cert.Status, string(data), cert.Fingerprint)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *SQLDB) FindCert(fingerprint string) (*CertInfo, error) {
+	var data string
+	err := m.queryRow(`SELECT data FROM certificate_details WHERE fingerprint = ?`, fingerprint).Scan(&data)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	cert := &CertInfo{}
+	if err := json.Unmarshal([]byte(data), cert); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+func (m *SQLDB) FindCerts(q CertQuery) ([]CertInfo, error) {
+	query := `SELECT data FROM certificate_details WHERE 1 = 1`
+	var args []interface{}
+	where := func(cond string, arg interface{}) {
+		query += ` AND ` + cond
+		args = append(args, arg)
+	}
+	if !q.NotAfterBefore.IsZero() {
+		where(`not_after < ?`, q.NotAfterBefore.UTC())
+	}
+	if !q.NotAfterAfter.IsZero() {
+		where(`not_after >= ?`, q.NotAfterAfter.UTC())
+	}
+	if !q.CreatedBefore.IsZero() {
+		where(`created < ?`, q.CreatedBefore.UTC())
+	}
+	if !q.CreatedAfter.IsZero() {
+		where(`created >= ?`, q.CreatedAfter.UTC())
+	}
+	if q.Suppressed != nil {
+		where(`suppressed = ?`, *q.Suppressed)
+	}
+	if q.Precert != nil {
+		where(`precert = ?`, *q.Precert)
+	}
+	if q.Name != "" {
+		where(`fingerprint IN (SELECT fingerprint FROM certificate_names WHERE name = ?)`, strings.ToLower(q.Name))
+	} else if q.NameSuffix != "" {
+		where(`fingerprint IN (SELECT fingerprint FROM certificate_names WHERE name LIKE ? ESCAPE '\')`,
+			"%"+likeEscaper.Replace(strings.ToLower(q.NameSuffix)))
+	}
+	if q.Issuer != "" {
+		where(`issuer = ?`, q.Issuer)
+	}
+	if q.Fingerprint != "" {
+		where(`fingerprint = ?`, q.Fingerprint)
+	}
+	if q.Rule != "" {
+		where(`fingerprint IN (SELECT fingerprint FROM certificate_rules WHERE rule = ?)`, q.Rule)
+	}
+	if q.Status != "" {
+		where(`status = ?`, q.Status)
+	}
+	if q.Assignee != "" {
+		where(`assignee = ?`, q.Assignee)
+	}
+	query += ` ORDER BY created`
+	if q.Desc {
+		query += ` DESC`
+	}
+	switch {
+	case q.Limit > 0:
+		query += fmt.Sprintf(` LIMIT %d OFFSET %d`, q.Limit, q.Offset)
+	case q.Offset > 0 && m.driver == "postgres":
+		query += fmt.Sprintf(` OFFSET %d`, q.Offset)
+	case q.Offset > 0:
+		/* SQLite has no OFFSET without LIMIT */
+		query += fmt.Sprintf(` LIMIT -1 OFFSET %d`, q.Offset)
+	}
+
+	rows, err := m.query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []CertInfo
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var cert CertInfo
+		if err := json.Unmarshal([]byte(data), &cert); err != nil {
+			return nil, err
+		}
+		result = append(result, cert)
+	}
+	return result, rows.Err()
+}
+
+func (m *SQLDB) UpdateTriage(fingerprint string, u *TriageUpdate) (*CertInfo, []models.TriageChange, error) {
+	var cert *CertInfo
+	var changes []models.TriageChange
+	err := m.updateCert(fingerprint, func(c *CertInfo) {
+		changes = u.Apply(c, time.Now().UTC())
+		cert = c
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, changes, nil
+}
+
+func (m *SQLDB) SetSuppressed(fingerprint string, suppressed bool) error {
+	return m.updateCert(fingerprint, func(cert *CertInfo) {
+		cert.Suppressed = suppressed
+	})
+}
+
+/* changes certificate details kept as JSON, columns follow the data */
+func (m *SQLDB) updateCert(fingerprint string, update func(cert *CertInfo)) error {
+	query := `SELECT data FROM certificate_details WHERE fingerprint = ?`
+	if m.driver == "postgres" {
+		/* SQLite has single writer anyway */
+		query += ` FOR UPDATE`
+	}
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+	var data string
+	if err := tx.QueryRow(m.rebind(query), fingerprint).Scan(&data); err != nil {
+		tx.Rollback()
+		return notFound(err)
+	}
+	var cert CertInfo
+	if err := json.Unmarshal([]byte(data), &cert); err != nil {
+		tx.Rollback()
+		return err
+	}
+	update(&cert)
+	updated, err := json.Marshal(&cert)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	_, err = tx.Exec(m.rebind(`UPDATE certificate_details SET suppressed = ?, status = ?, assignee = ?, data = ?
+		WHERE fingerprint = ?`), cert.Suppressed, cert.Status, cert.Assignee, string(updated), fingerprint)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (m *SQLDB) DeleteCerts(fingerprints []string) (int, error) {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, fp := range fingerprints {
+		if _, err := tx.Exec(m.rebind(`DELETE FROM certificate_names WHERE fingerprint = ?`), fp); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		if _, err := tx.Exec(m.rebind(`DELETE FROM certificate_rules WHERE fingerprint = ?`), fp); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		res, err := tx.Exec(m.rebind(`DELETE FROM certificate_details WHERE fingerprint = ?`), fp)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		n, _ := res.RowsAffected()
+		deleted += int(n)
+	}
+	return deleted, tx.Commit()
+}
+
+// FindCertByIndex returns certificate seen at |index| of |logUri|, any log if |logUri| is empty.
+func (m *SQLDB) FindCertByIndex(logUri string, index int64) (*CertInfo, error) {
+	fingerprint, err := m.FindFingerprint(logUri, index)
+	if err != nil {
+		return nil, err
+	}
+	return m.FindCert(fingerprint)
+}
+
+// AddSighting records certificate |fingerprint| seen in log, returns true on the first sighting.
+func (m *SQLDB) AddSighting(fingerprint string, precert bool, s Sighting) (bool, error) {
+	now := time.Now().UTC()
+	res, err := m.exec(`INSERT INTO fingerprints (fingerprint, precert, created, updated) VALUES (?, ?, ?, ?)
+		ON CONFLICT (fingerprint) DO NOTHING`, fingerprint, precert, now, now)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	first := n > 0
+	if !first {
+		if _, err := m.exec(`UPDATE fingerprints SET updated = ? WHERE fingerprint = ?`, now, fingerprint); err != nil {
+			return false, err
+		}
+	}
+
+	/* rescans see the same entries again */
+	_, err = m.exec(`INSERT INTO sightings (fingerprint, log_uri, log_index, timestamp) VALUES (?, ?, ?, ?)
+		ON CONFLICT (fingerprint, log_uri, log_index) DO NOTHING`, fingerprint, s.Log, s.Index, s.Timestamp.UTC())
+	return first, err
+}
+
+func (m *SQLDB) FindSightings(fingerprint string) (*SightingInfo, error) {
+	info := &SightingInfo{Fingerprint: fingerprint}
+	err := m.queryRow(`SELECT precert, created, updated FROM fingerprints WHERE fingerprint = ?`, fingerprint).
+		Scan(&info.Precert, &info.Created, &info.Updated)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	rows, err := m.query(`SELECT log_uri, log_index, timestamp FROM sightings WHERE fingerprint = ?
+		ORDER BY timestamp`, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var s Sighting
+		if err := rows.Scan(&s.Log, &s.Index, &s.Timestamp); err != nil {
+			return nil, err
+		}
+		info.Sightings = append(info.Sightings, s)
+	}
+	return info, rows.Err()
+}
+
+// PurgeSightings removes certificates last seen before |before| with all their sightings.
+func (m *SQLDB) PurgeSightings(before time.Time) (int, error) {
+	before = before.UTC()
+	_, err := m.exec(`DELETE FROM sightings WHERE fingerprint IN
+		(SELECT fingerprint FROM fingerprints WHERE updated < ?)`, before)
+	if err != nil {
+		return 0, err
+	}
+	res, err := m.exec(`DELETE FROM fingerprints WHERE updated < ?`, before)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
+
+// FindFingerprint returns fingerprint of certificate seen at |index| of |logUri|, any log if |logUri| is empty.
+func (m *SQLDB) FindFingerprint(logUri string, index int64) (string, error) {
+	var fingerprint string
+	var err error
+	if logUri == "" {
+		err = m.queryRow(`SELECT fingerprint FROM sightings WHERE log_index = ? LIMIT 1`, index).Scan(&fingerprint)
+	} else {
+		err = m.queryRow(`SELECT fingerprint FROM sightings WHERE log_uri = ? AND log_index = ?`,
+			logUri, index).Scan(&fingerprint)
+	}
+	return fingerprint, notFound(err)
+}
+
+func (m *SQLDB) StorePrecert(p *PrecertInfo) error {
+	p.Created = time.Now().UTC()
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	/* same precert can be submitted to several logs */
+	_, err = m.exec(`INSERT INTO precerts (tbs_hash, linked, created, data) VALUES (?, ?, ?, ?)
+		ON CONFLICT (tbs_hash) DO NOTHING`, p.TBSHash, false, p.Created, string(data))
+	return err
+}
+
+// LinkPrecert marks precert with |tbsHash| as followed by certificate at |certIndex| of |certLog|,
+// returns false if no such precert was seen or it is linked already.
+func (m *SQLDB) LinkPrecert(tbsHash string, certLog string, certIndex int64) (bool, error) {
+	res, err := m.exec(`UPDATE precerts SET linked = ?, cert_log = ?, cert_index = ?, cert_seen = ?
+		WHERE tbs_hash = ? AND linked = ?`, true, certLog, certIndex, time.Now().UTC(), tbsHash, false)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// PurgePrecerts removes precerts seen before |before|, linked or not.
+func (m *SQLDB) PurgePrecerts(before time.Time) (int, error) {
+	res, err := m.exec(`DELETE FROM precerts WHERE created < ?`, before.UTC())
+	if err != nil {
+		return 0, err
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
+
+// UnlinkedPrecerts returns precerts seen before |before| never followed by a certificate.
+func (m *SQLDB) UnlinkedPrecerts(before time.Time) ([]PrecertInfo, error) {
+	rows, err := m.query(`SELECT data FROM precerts WHERE linked = ? AND created < ? ORDER BY created`,
+		false, before.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []PrecertInfo
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var p PrecertInfo
+		if err := json.Unmarshal([]byte(data), &p); err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	return result, rows.Err()
+}
+
+func (m *SQLDB) AddSuppression(s *Suppression) error {
+	s.Id = bson.NewObjectId()
+	s.Created = time.Now().UTC()
+	_, err := m.exec(`INSERT INTO suppressions (id, fingerprint, name, reason, created) VALUES (?, ?, ?, ?, ?)`,
+		s.Id.Hex(), s.Fingerprint, s.Name, s.Reason, s.Created)
+	return err
+}
+
+func (m *SQLDB) RemoveSuppression(id string) error {
+	res, err := m.exec(`DELETE FROM suppressions WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (m *SQLDB) Suppressions() ([]Suppression, error) {
+	rows, err := m.query(`SELECT id, fingerprint, name, reason, created FROM suppressions ORDER BY created`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Suppression
+	for rows.Next() {
+		var s Suppression
+		var id string
+		if err := rows.Scan(&id, &s.Fingerprint, &s.Name, &s.Reason, &s.Created); err != nil {
+			return nil, err
+		}
+		if bson.IsObjectIdHex(id) {
+			s.Id = bson.ObjectIdHex(id)
+		}
+		result = append(result, s)
+	}
+	return result, rows.Err()
+}
+
+func (m *SQLDB) EnqueueNotification(n *Notification) error {
+	n.Id = bson.NewObjectId()
+	n.Status = OutboxPending
+	n.Created = time.Now().UTC()
+	n.Updated = n.Created
+	_, err := m.exec(`INSERT INTO outbox (id, sink, payload, status, attempts, next_attempt, last_error, created, updated)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		n.Id.Hex(), n.Sink, string(n.Payload), n.Status, n.Attempts, n.NextAttempt, n.LastError, n.Created, n.Updated)
+	return err
+}
+
+func (m *SQLDB) UpdateNotification(n *Notification) error {
+	n.Updated = time.Now().UTC()
+	res, err := m.exec(`UPDATE outbox SET status = ?, attempts = ?, next_attempt = ?, last_error = ?, updated = ?
+		WHERE id = ?`, n.Status, n.Attempts, n.NextAttempt, n.LastError, n.Updated, n.Id.Hex())
+	if err != nil {
+		return err
+	}
+	if c, _ := res.RowsAffected(); c == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (m *SQLDB) DueNotifications(now time.Time, limit int) ([]Notification, error) {
+	rows, err := m.query(`SELECT id, sink, payload, status, attempts, next_attempt, last_error, created, updated
+		FROM outbox WHERE status = ? AND next_attempt <= ? ORDER BY next_attempt LIMIT ?`, OutboxPending, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Notification
+	for rows.Next() {
+		var n Notification
+		var id, payload string
+		if err := rows.Scan(&id, &n.Sink, &payload, &n.Status, &n.Attempts, &n.NextAttempt, &n.LastError,
+			&n.Created, &n.Updated); err != nil {
+			return nil, err
+		}
+		if bson.IsObjectIdHex(id) {
+			n.Id = bson.ObjectIdHex(id)
+		}
+		n.Payload = []byte(payload)
+		result = append(result, n)
+	}
+	return result, rows.Err()
+}
+
+func (m *SQLDB) PurgeNotifications(before time.Time) (int, error) {
+	res, err := m.exec(`DELETE FROM outbox WHERE status != ? AND updated < ?`, OutboxPending, before)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
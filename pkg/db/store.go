@@ -0,0 +1,145 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+// ErrNotFound is returned by all backends when nothing matches the query.
+var ErrNotFound = errors.New("not found")
+
+// Store is implemented by storage backends keeping monitor state and matches.
+type Store interface {
+	StateStore
+
+	StoreCertDetails(cert *CertInfo) error
+	FindCert(fingerprint string) (*CertInfo, error)
+	FindCertByIndex(logUri string, index int64) (*CertInfo, error)
+	FindCerts(q CertQuery) ([]CertInfo, error)
+	DeleteCerts(fingerprints []string) (int, error)
+	UpdateTriage(fingerprint string, u *TriageUpdate) (*CertInfo, []models.TriageChange, error)
+	SetSuppressed(fingerprint string, suppressed bool) error
+
+	AddSighting(fingerprint string, precert bool, s Sighting) (bool, error)
+	FindSightings(fingerprint string) (*SightingInfo, error)
+	FindFingerprint(logUri string, index int64) (string, error)
+	PurgeSightings(before time.Time) (int, error)
+
+	StorePrecert(p *PrecertInfo) error
+	LinkPrecert(tbsHash string, certLog string, certIndex int64) (bool, error)
+	UnlinkedPrecerts(before time.Time) ([]PrecertInfo, error)
+	PurgePrecerts(before time.Time) (int, error)
+
+	AddSuppression(s *Suppression) error
+	RemoveSuppression(id string) error
+	Suppressions() ([]Suppression, error)
+
+	EnqueueNotification(n *Notification) error
+	UpdateNotification(n *Notification) error
+	DueNotifications(now time.Time, limit int) ([]Notification, error)
+	PurgeNotifications(before time.Time) (int, error)
+
+	Ping() error
+	Close() error
+}
+
+// CertQuery selects stored certificates, zero fields are not used.
+// Ranges include "After" and exclude "Before" bounds.
+type CertQuery struct {
+	NotAfterBefore time.Time
+	NotAfterAfter  time.Time
+	CreatedBefore  time.Time
+	CreatedAfter   time.Time
+	Suppressed     *bool
+	Precert        *bool
+	/* CN or SAN, exact or ending with |NameSuffix|, e.g. ".example.com" */
+	Name        string
+	NameSuffix  string
+	Issuer      string
+	Fingerprint string
+	Rule        string
+	Status      models.TriageStatus
+	Assignee    string
+	/* newest first, oldest first by default */
+	Desc   bool
+	Offset int
+	Limit  int
+}
+
+// TriageUpdate changes triage state of stored certificate by analyst |By|, nil fields are not changed.
+type TriageUpdate struct {
+	By       string
+	Status   *models.TriageStatus
+	Assignee *string
+	/* added to history if not empty */
+	Note string
+}
+
+// Apply changes |c| at |now|, returns audit records of fields changed, they are added to |c| history too.
+func (u *TriageUpdate) Apply(c *CertInfo, now time.Time) []models.TriageChange {
+	var changes []models.TriageChange
+	change := func(field string, from string, to string) {
+		changes = append(changes, models.TriageChange{Time: now, By: u.By, Field: field, From: from, To: to})
+	}
+	if c.Status == "" {
+		c.Status = models.TriageNew
+	}
+	if u.Status != nil && *u.Status != c.Status {
+		change(models.TriageFieldStatus, string(c.Status), string(*u.Status))
+		c.Status = *u.Status
+	}
+	if u.Assignee != nil && *u.Assignee != c.Assignee {
+		change(models.TriageFieldAssignee, c.Assignee, *u.Assignee)
+		c.Assignee = *u.Assignee
+	}
+	if u.Note != "" {
+		change(models.TriageFieldNote, "", u.Note)
+	}
+	c.History = append(c.History, changes...)
+	return changes
+}
+
+// Open connects to storage backend of |dbType|: mongo, sqlite or postgres.
+func Open(dbType string, uri string) (Store, error) {
+	switch dbType {
+	case "", "mongo":
+		return Init(uri)
+	case "sqlite":
+		return InitSQL("sqlite3", uri)
+	case "postgres":
+		return InitSQL("postgres", uri)
+	}
+	return nil, fmt.Errorf("unknown DB type %q", dbType)
+}
+
+// Suppressed returns true if certificate |fingerprint| with |names| matches any of |suppressions|.
+func Suppressed(suppressions []Suppression, fingerprint string, names []string) bool {
+	for _, s := range suppressions {
+		if s.Fingerprint != "" && s.Fingerprint == fingerprint {
+			return true
+		}
+		if s.Name == "" {
+			continue
+		}
+		for _, name := range names {
+			if matchName(s.Name, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/* "*.example.com" suppresses every name under example.com */
+func matchName(pattern string, name string) bool {
+	pattern = strings.ToLower(pattern)
+	name = strings.ToLower(name)
+	if strings.HasPrefix(pattern, "*.") {
+		return strings.HasSuffix(name, pattern[1:])
+	}
+	return pattern == name
+}
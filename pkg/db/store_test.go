@@ -0,0 +1,571 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+/* disposable databases, everything in them is dropped before the run */
+const (
+	mongoEnv    = "CT_MON_TEST_MONGO"
+	postgresEnv = "CT_MON_TEST_POSTGRES"
+)
+
+func TestSQLiteStore(t *testing.T) {
+	s, err := InitSQL("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	testStore(t, s)
+}
+
+func TestSQLiteLowercaseNames(t *testing.T) {
+	s, err := InitSQL("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	/* names as stored before version 6 */
+	for _, stmt := range []string{
+		`INSERT INTO certificate_names (fingerprint, name) VALUES ('c1', 'WWW.Example.com'), ('c1', 'www.example.com'),
+			('c2', 'Mail.Example.org')`,
+		`UPDATE schema_version SET version = 5`,
+	} {
+		if _, err := s.db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := s.db.Query(`SELECT fingerprint || ' ' || name FROM certificate_names`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		rows.Scan(&name)
+		names = append(names, name)
+	}
+	if !sameStrings(names, "c1 www.example.com", "c2 mail.example.org") {
+		t.Errorf("names after migration %q", names)
+	}
+}
+
+func TestPostgresStore(t *testing.T) {
+	uri := os.Getenv(postgresEnv)
+	if uri == "" {
+		t.Skipf("%s is not set", postgresEnv)
+	}
+	db, err := sql.Open("postgres", uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec(`DROP SCHEMA public CASCADE; CREATE SCHEMA public`)
+	db.Close()
+	if err != nil {
+		t.Skipf("PostgreSQL is not available (%v)", err)
+	}
+
+	s, err := InitSQL("postgres", uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	testStore(t, s)
+}
+
+func TestMongoStore(t *testing.T) {
+	uri := os.Getenv(mongoEnv)
+	if uri == "" {
+		t.Skipf("%s is not set", mongoEnv)
+	}
+	session, err := mgo.DialWithTimeout(uri, 5*time.Second)
+	if err != nil {
+		t.Skipf("MongoDB is not available (%v)", err)
+	}
+	err = session.DB("").DropDatabase()
+	session.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := Init(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	testStore(t, s)
+}
+
+/* backends keep time with different precision */
+func sameTime(a time.Time, b time.Time) bool {
+	d := a.Sub(b)
+	return d < time.Millisecond && d > -time.Millisecond
+}
+
+func fingerprints(certs []CertInfo) []string {
+	var result []string
+	for _, c := range certs {
+		result = append(result, c.Fingerprint)
+	}
+	return result
+}
+
+func sameStrings(a []string, b ...string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// testStore is conformance suite every Store backend must pass on an empty database.
+func testStore(t *testing.T, s Store) {
+	if err := s.Ping(); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	t.Run("State", func(t *testing.T) { testState(t, s) })
+	t.Run("Certs", func(t *testing.T) { testCerts(t, s) })
+	t.Run("Sightings", func(t *testing.T) { testSightings(t, s) })
+	t.Run("Precerts", func(t *testing.T) { testPrecerts(t, s) })
+	t.Run("Suppressions", func(t *testing.T) { testSuppressions(t, s) })
+	t.Run("Triage", func(t *testing.T) { testTriage(t, s) })
+	t.Run("Outbox", func(t *testing.T) { testOutbox(t, s) })
+}
+
+func testState(t *testing.T, s Store) {
+	if _, err := s.LoadState("https://log.example.com/"); err != ErrNotFound {
+		t.Errorf("LoadState of unknown log: %v, want ErrNotFound", err)
+	}
+	for _, index := range []int64{100, 250} {
+		if err := s.SaveState("https://log.example.com/", index); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := s.LoadState("https://log.example.com/"); err != nil || got != index {
+			t.Errorf("LoadState: %d (%v), want %d", got, err, index)
+		}
+	}
+	if _, err := s.LoadState("https://other.example.com/"); err != ErrNotFound {
+		t.Errorf("LoadState of other log: %v, want ErrNotFound", err)
+	}
+}
+
+func testCerts(t *testing.T, s Store) {
+	now := time.Now().UTC()
+	stored := []*CertInfo{
+		{Fingerprint: "c1", CommonName: "www.example.com", DNSNames: []string{"www.example.com", "example.com"},
+			Issuer: "CA one", NotAfter: now.Add(-48 * time.Hour), Rules: []string{"brand"}, Severity: "high",
+			Chain: [][]byte{{1, 2, 3}}, LeafInput: []byte{4, 5}},
+		{Fingerprint: "c2", CommonName: "mail.example.org", DNSNames: []string{"mail.example.org"},
+			Issuer: "CA two", NotAfter: now.Add(48 * time.Hour), Precert: true, Suppressed: true},
+		{Fingerprint: "c3", CommonName: "Shop.Example.com", Issuer: "CA one", NotAfter: now.Add(96 * time.Hour),
+			Rules: []string{"brand", "shop"}},
+	}
+	for _, c := range stored {
+		if err := s.StoreCertDetails(c); err != nil {
+			t.Fatal(err)
+		}
+		/* created order must be strict for sorting checks */
+		time.Sleep(5 * time.Millisecond)
+	}
+	/* storing again keeps the first copy */
+	if err := s.StoreCertDetails(&CertInfo{Fingerprint: "c1", CommonName: "changed"}); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := s.FindCert("c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.CommonName != "www.example.com" || c.Issuer != "CA one" || c.Severity != "high" ||
+		!sameStrings(c.DNSNames, "www.example.com", "example.com") || !sameStrings(c.Rules, "brand") ||
+		len(c.Chain) != 1 || string(c.LeafInput) != "\x04\x05" || !sameTime(c.NotAfter, stored[0].NotAfter) {
+		t.Errorf("FindCert: %+v", c)
+	}
+	if c.Status != models.TriageNew {
+		t.Errorf("new certificate status %q", c.Status)
+	}
+	if _, err := s.FindCert("missing"); err != ErrNotFound {
+		t.Errorf("FindCert of missing: %v, want ErrNotFound", err)
+	}
+
+	yes, no := true, false
+	tests := []struct {
+		name string
+		q    CertQuery
+		want []string
+	}{
+		{"all", CertQuery{}, []string{"c1", "c2", "c3"}},
+		{"name", CertQuery{Name: "example.com"}, []string{"c1"}},
+		{"name cn", CertQuery{Name: "shop.example.com"}, []string{"c3"}},
+		{"name case", CertQuery{Name: "WWW.example.COM"}, []string{"c1"}},
+		{"name suffix", CertQuery{NameSuffix: ".example.com"}, []string{"c1", "c3"}},
+		{"name suffix case", CertQuery{NameSuffix: ".EXAMPLE.org"}, []string{"c2"}},
+		{"issuer", CertQuery{Issuer: "CA one"}, []string{"c1", "c3"}},
+		{"fingerprint", CertQuery{Fingerprint: "c2"}, []string{"c2"}},
+		{"rule", CertQuery{Rule: "brand"}, []string{"c1", "c3"}},
+		{"precert", CertQuery{Precert: &yes}, []string{"c2"}},
+		{"not suppressed", CertQuery{Suppressed: &no}, []string{"c1", "c3"}},
+		{"suppressed", CertQuery{Suppressed: &yes}, []string{"c2"}},
+		{"expired", CertQuery{NotAfterBefore: now}, []string{"c1"}},
+		{"valid", CertQuery{NotAfterAfter: now}, []string{"c2", "c3"}},
+		{"created", CertQuery{CreatedAfter: now.Add(-time.Minute), CreatedBefore: now.Add(time.Minute)},
+			[]string{"c1", "c2", "c3"}},
+		{"created before", CertQuery{CreatedBefore: now.Add(-time.Minute)}, nil},
+		{"status", CertQuery{Status: models.TriageNew}, []string{"c1", "c2", "c3"}},
+		{"combined", CertQuery{Issuer: "CA one", Rule: "shop"}, []string{"c3"}},
+	}
+	for _, tt := range tests {
+		certs, err := s.FindCerts(tt.q)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := fingerprints(certs); !sameStrings(got, tt.want...) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	certs, err := s.FindCerts(CertQuery{Desc: true, Offset: 1, Limit: 1})
+	if err != nil || len(certs) != 1 || certs[0].Fingerprint != "c2" {
+		t.Errorf("newest first page 2: %v (%v)", fingerprints(certs), err)
+	}
+	certs, err = s.FindCerts(CertQuery{Limit: 2})
+	if got := fingerprints(certs); err != nil || len(got) != 2 || got[0] != "c1" || got[1] != "c2" {
+		t.Errorf("oldest first: %v (%v)", got, err)
+	}
+	certs, err = s.FindCerts(CertQuery{Offset: 1})
+	if got := fingerprints(certs); err != nil || len(got) != 2 || got[0] != "c2" || got[1] != "c3" {
+		t.Errorf("offset without limit: %v (%v)", got, err)
+	}
+
+	n, err := s.DeleteCerts([]string{"c1", "c3", "missing"})
+	if err != nil || n != 2 {
+		t.Errorf("DeleteCerts: %d (%v), want 2", n, err)
+	}
+	certs, err = s.FindCerts(CertQuery{Rule: "brand"})
+	if err != nil || len(certs) != 0 {
+		t.Errorf("rules of deleted certificates found: %v (%v)", fingerprints(certs), err)
+	}
+	if _, err := s.FindCert("c1"); err != ErrNotFound {
+		t.Errorf("FindCert of deleted: %v, want ErrNotFound", err)
+	}
+	if _, err := s.DeleteCerts([]string{"c2"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testSightings(t *testing.T, s Store) {
+	ts := time.Date(2016, 3, 1, 0, 0, 0, 0, time.UTC)
+	a := Sighting{Log: "https://a.example.com/", Index: 10, Timestamp: ts}
+	b := Sighting{Log: "https://b.example.com/", Index: 20, Timestamp: ts.Add(time.Minute)}
+
+	if first, err := s.AddSighting("s1", true, a); err != nil || !first {
+		t.Errorf("first sighting: %v (%v)", first, err)
+	}
+	/* rescan of the same entry */
+	if first, err := s.AddSighting("s1", true, a); err != nil || first {
+		t.Errorf("repeated sighting: %v (%v)", first, err)
+	}
+	if first, err := s.AddSighting("s1", true, b); err != nil || first {
+		t.Errorf("sighting in another log: %v (%v)", first, err)
+	}
+
+	info, err := s.FindSightings("s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Fingerprint != "s1" || !info.Precert || len(info.Sightings) != 2 {
+		t.Fatalf("FindSightings: %+v", info)
+	}
+	got := info.Sightings
+	sort.Slice(got, func(i, j int) bool { return got[i].Index < got[j].Index })
+	if got[0].Log != a.Log || got[0].Index != 10 || !sameTime(got[0].Timestamp, ts) || got[1].Log != b.Log {
+		t.Errorf("sightings: %+v", got)
+	}
+	if _, err := s.FindSightings("missing"); err != ErrNotFound {
+		t.Errorf("FindSightings of missing: %v, want ErrNotFound", err)
+	}
+
+	if fp, err := s.FindFingerprint(b.Log, 20); err != nil || fp != "s1" {
+		t.Errorf("FindFingerprint: %q (%v)", fp, err)
+	}
+	if fp, err := s.FindFingerprint("", 10); err != nil || fp != "s1" {
+		t.Errorf("FindFingerprint in any log: %q (%v)", fp, err)
+	}
+	if _, err := s.FindFingerprint(a.Log, 20); err != ErrNotFound {
+		t.Errorf("FindFingerprint of other log: %v, want ErrNotFound", err)
+	}
+
+	if err := s.StoreCertDetails(&CertInfo{Fingerprint: "s1", CommonName: "seen.example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := s.FindCertByIndex(a.Log, 10); err != nil || c.CommonName != "seen.example.com" {
+		t.Errorf("FindCertByIndex: %+v (%v)", c, err)
+	}
+	if _, err := s.FindCertByIndex(a.Log, 11); err != ErrNotFound {
+		t.Errorf("FindCertByIndex of unknown index: %v, want ErrNotFound", err)
+	}
+
+	if n, err := s.PurgeSightings(time.Now().UTC().Add(-time.Hour)); err != nil || n != 0 {
+		t.Errorf("PurgeSightings of recent: %d (%v)", n, err)
+	}
+	if n, err := s.PurgeSightings(time.Now().UTC().Add(time.Minute)); err != nil || n != 1 {
+		t.Errorf("PurgeSightings: %d (%v), want 1", n, err)
+	}
+	if _, err := s.FindSightings("s1"); err != ErrNotFound {
+		t.Errorf("FindSightings after purge: %v, want ErrNotFound", err)
+	}
+	if _, err := s.FindFingerprint(a.Log, 10); err != ErrNotFound {
+		t.Errorf("FindFingerprint after purge: %v, want ErrNotFound", err)
+	}
+	if first, err := s.AddSighting("s1", true, a); err != nil || !first {
+		t.Errorf("sighting after purge: %v (%v)", first, err)
+	}
+}
+
+func testPrecerts(t *testing.T, s Store) {
+	for _, hash := range []string{"t1", "t2"} {
+		p := &PrecertInfo{TBSHash: hash, Log: "https://a.example.com/", Index: 5,
+			CommonName: "pre.example.com", DNSNames: []string{"pre.example.com"}}
+		if err := s.StorePrecert(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	/* the same precert submitted to another log */
+	if err := s.StorePrecert(&PrecertInfo{TBSHash: "t1", Log: "https://b.example.com/"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if linked, err := s.LinkPrecert("t1", "https://a.example.com/", 7); err != nil || !linked {
+		t.Errorf("LinkPrecert: %v (%v)", linked, err)
+	}
+	/* another final certificate with the same TBS, e.g. from another log */
+	if linked, err := s.LinkPrecert("t1", "https://b.example.com/", 9); err != nil || linked {
+		t.Errorf("LinkPrecert of linked precert: %v (%v)", linked, err)
+	}
+	if linked, err := s.LinkPrecert("unknown", "https://a.example.com/", 8); err != nil || linked {
+		t.Errorf("LinkPrecert of unknown: %v (%v)", linked, err)
+	}
+
+	unlinked, err := s.UnlinkedPrecerts(time.Now().UTC().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unlinked) != 1 || unlinked[0].TBSHash != "t2" || unlinked[0].CommonName != "pre.example.com" ||
+		unlinked[0].Log != "https://a.example.com/" {
+		t.Errorf("UnlinkedPrecerts: %+v", unlinked)
+	}
+	if unlinked, err := s.UnlinkedPrecerts(time.Now().UTC().Add(-time.Hour)); err != nil || len(unlinked) != 0 {
+		t.Errorf("UnlinkedPrecerts before they were seen: %+v (%v)", unlinked, err)
+	}
+
+	if n, err := s.PurgePrecerts(time.Now().UTC().Add(-time.Hour)); err != nil || n != 0 {
+		t.Errorf("PurgePrecerts of recent: %d (%v)", n, err)
+	}
+	if n, err := s.PurgePrecerts(time.Now().UTC().Add(time.Minute)); err != nil || n != 2 {
+		t.Errorf("PurgePrecerts: %d (%v), want 2", n, err)
+	}
+	if unlinked, err := s.UnlinkedPrecerts(time.Now().UTC().Add(time.Minute)); err != nil || len(unlinked) != 0 {
+		t.Errorf("UnlinkedPrecerts after purge: %+v (%v)", unlinked, err)
+	}
+	if linked, err := s.LinkPrecert("t2", "https://a.example.com/", 7); err != nil || linked {
+		t.Errorf("LinkPrecert after purge: %v (%v)", linked, err)
+	}
+}
+
+func testSuppressions(t *testing.T, s Store) {
+	byName := &Suppression{Name: "*.example.net", Reason: "ours"}
+	byFingerprint := &Suppression{Fingerprint: "f1", Reason: "known"}
+	for _, sup := range []*Suppression{byName, byFingerprint} {
+		if err := s.AddSuppression(sup); err != nil {
+			t.Fatal(err)
+		}
+		if !sup.Id.Valid() || sup.Created.IsZero() {
+			t.Errorf("AddSuppression: %+v", sup)
+		}
+	}
+
+	list, err := s.Suppressions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0].Id != byName.Id || list[0].Name != "*.example.net" || list[0].Reason != "ours" ||
+		list[1].Fingerprint != "f1" {
+		t.Errorf("Suppressions: %+v", list)
+	}
+
+	if err := s.RemoveSuppression(byName.Id.Hex()); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RemoveSuppression(byName.Id.Hex()); err != ErrNotFound {
+		t.Errorf("RemoveSuppression twice: %v, want ErrNotFound", err)
+	}
+	if err := s.RemoveSuppression("bad id"); err != ErrNotFound {
+		t.Errorf("RemoveSuppression of bad id: %v, want ErrNotFound", err)
+	}
+	if list, err := s.Suppressions(); err != nil || len(list) != 1 || list[0].Id != byFingerprint.Id {
+		t.Errorf("Suppressions after remove: %+v (%v)", list, err)
+	}
+	if err := s.RemoveSuppression(byFingerprint.Id.Hex()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testTriage(t *testing.T, s Store) {
+	if err := s.StoreCertDetails(&CertInfo{Fingerprint: "r1", CommonName: "triage.example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	status, assignee := models.TriageMalicious, "alice"
+	c, changes, err := s.UpdateTriage("r1", &TriageUpdate{By: "bob", Status: &status, Assignee: &assignee,
+		Note: "phishing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Status != status || c.Assignee != assignee || len(changes) != 3 {
+		t.Fatalf("UpdateTriage: %+v %+v", c, changes)
+	}
+	if ch := changes[0]; ch.Field != models.TriageFieldStatus || ch.From != "new" || ch.To != "malicious" ||
+		ch.By != "bob" {
+		t.Errorf("status change: %+v", ch)
+	}
+
+	/* nothing changes, nothing is recorded */
+	if _, changes, err := s.UpdateTriage("r1", &TriageUpdate{By: "bob", Status: &status}); err != nil ||
+		len(changes) != 0 {
+		t.Errorf("repeated update: %+v (%v)", changes, err)
+	}
+
+	stored, err := s.FindCert("r1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != status || stored.Assignee != assignee || len(stored.History) != 3 ||
+		stored.History[2].To != "phishing" {
+		t.Errorf("stored triage: %+v", stored)
+	}
+	for _, q := range []CertQuery{{Status: status}, {Assignee: assignee}} {
+		if certs, err := s.FindCerts(q); err != nil || !sameStrings(fingerprints(certs), "r1") {
+			t.Errorf("FindCerts %+v: %v (%v)", q, fingerprints(certs), err)
+		}
+	}
+
+	if err := s.SetSuppressed("r1", true); err != nil {
+		t.Fatal(err)
+	}
+	yes := true
+	if certs, err := s.FindCerts(CertQuery{Suppressed: &yes}); err != nil || !sameStrings(fingerprints(certs), "r1") {
+		t.Errorf("suppressed: %v (%v)", fingerprints(certs), err)
+	}
+	if stored, err := s.FindCert("r1"); err != nil || !stored.Suppressed || stored.Status != status {
+		t.Errorf("suppressed certificate: %+v (%v)", stored, err)
+	}
+
+	if _, _, err := s.UpdateTriage("missing", &TriageUpdate{By: "bob", Status: &status}); err != ErrNotFound {
+		t.Errorf("UpdateTriage of missing: %v, want ErrNotFound", err)
+	}
+	if err := s.SetSuppressed("missing", true); err != ErrNotFound {
+		t.Errorf("SetSuppressed of missing: %v, want ErrNotFound", err)
+	}
+
+	/* concurrent reassignments are applied one after another, none is lost */
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(assignee string) {
+			defer wg.Done()
+			if _, _, err := s.UpdateTriage("r1", &TriageUpdate{By: assignee, Assignee: &assignee}); err != nil {
+				t.Errorf("concurrent UpdateTriage: %v", err)
+			}
+		}(fmt.Sprintf("analyst%d", i))
+	}
+	wg.Wait()
+	stored, err = s.FindCert("r1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev, n := assignee, 0
+	for _, ch := range stored.History[3:] {
+		if ch.Field != models.TriageFieldAssignee || ch.From != prev {
+			t.Errorf("reassignment %+v after %q", ch, prev)
+		}
+		prev = ch.To
+		n++
+	}
+	if n != 8 || stored.Assignee != prev {
+		t.Errorf("%d reassignments, assignee %q after %q", n, stored.Assignee, prev)
+	}
+}
+
+func testOutbox(t *testing.T, s Store) {
+	now := time.Now().UTC()
+	due := &Notification{Sink: "mail", Payload: []byte(`{"fingerprint":"o1"}`), NextAttempt: now.Add(-time.Second)}
+	later := &Notification{Sink: "webhook", Payload: []byte(`{}`), NextAttempt: now.Add(time.Hour)}
+	for _, n := range []*Notification{due, later} {
+		if err := s.EnqueueNotification(n); err != nil {
+			t.Fatal(err)
+		}
+		if !n.Id.Valid() || n.Status != OutboxPending {
+			t.Errorf("EnqueueNotification: %+v", n)
+		}
+	}
+
+	list, err := s.DueNotifications(now, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Id != due.Id || list[0].Sink != "mail" || string(list[0].Payload) != string(due.Payload) {
+		t.Fatalf("DueNotifications: %+v", list)
+	}
+
+	due.Attempts++
+	due.LastError = "timeout"
+	due.NextAttempt = now.Add(2 * time.Hour)
+	if err := s.UpdateNotification(due); err != nil {
+		t.Fatal(err)
+	}
+	if list, err := s.DueNotifications(now.Add(90*time.Minute), 10); err != nil || len(list) != 1 ||
+		list[0].Id != later.Id {
+		t.Errorf("DueNotifications after retry scheduled: %+v (%v)", list, err)
+	}
+	list, err = s.DueNotifications(now.Add(3*time.Hour), 1)
+	if err != nil || len(list) != 1 || list[0].Id != later.Id {
+		t.Errorf("DueNotifications limit: %+v (%v)", list, err)
+	}
+
+	due.Status = OutboxDelivered
+	if err := s.UpdateNotification(due); err != nil {
+		t.Fatal(err)
+	}
+	if list, err := s.DueNotifications(now.Add(3*time.Hour), 10); err != nil || len(list) != 1 {
+		t.Errorf("delivered notification is due: %+v (%v)", list, err)
+	}
+	if n, err := s.PurgeNotifications(now.Add(time.Minute)); err != nil || n != 1 {
+		t.Errorf("PurgeNotifications: %d (%v), want 1", n, err)
+	}
+	if err := s.UpdateNotification(&Notification{Id: bson.NewObjectId()}); err != ErrNotFound {
+		t.Errorf("UpdateNotification of missing: %v, want ErrNotFound", err)
+	}
+}
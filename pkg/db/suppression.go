@@ -0,0 +1,56 @@
+package db
+
+import (
+	"log"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+type Suppression struct {
+	Id          bson.ObjectId `json:"id,omitempty" bson:"_id"`
+	Fingerprint string        `bson:"fingerprint,omitempty"`
+	Name        string        `bson:"name,omitempty"`
+	Reason      string        `bson:"reason"`
+	Created     time.Time     `bson:"created"`
+}
+
+func (m *MonDB) AddSuppression(s *Suppression) error {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return err
+	}
+	defer session.Close()
+
+	s.Id = bson.NewObjectId()
+	s.Created = time.Now().UTC()
+	return session.DB("").C("suppressions").Insert(s)
+}
+
+func (m *MonDB) RemoveSuppression(id string) error {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return err
+	}
+	defer session.Close()
+
+	if !bson.IsObjectIdHex(id) {
+		return ErrNotFound
+	}
+	return mgoNotFound(session.DB("").C("suppressions").RemoveId(bson.ObjectIdHex(id)))
+}
+
+func (m *MonDB) Suppressions() ([]Suppression, error) {
+	session, err := m.getSession()
+	if err != nil {
+		log.Printf("DB connection error (%v)\n", err)
+		return nil, err
+	}
+	defer session.Close()
+
+	var result []Suppression
+	err = session.DB("").C("suppressions").Find(nil).Sort("created").All(&result)
+	return result, err
+}
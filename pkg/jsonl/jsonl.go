@@ -0,0 +1,145 @@
+package jsonl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+// RotatingFile is io.Writer appending to |Path|, rotated to Path.1 ... Path.N on |MaxSize| bytes.
+type RotatingFile struct {
+	Path       string
+	MaxSize    int64
+	MaxBackups int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+func (r *RotatingFile) open() error {
+	f, err := os.OpenFile(r.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.file = f
+	r.size = info.Size()
+	return nil
+}
+
+func (r *RotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	r.file = nil
+	for i := r.MaxBackups - 1; i > 0; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", r.Path, i), fmt.Sprintf("%s.%d", r.Path, i+1))
+	}
+	/* file may be moved away by external logrotate */
+	var err error
+	if r.MaxBackups > 0 {
+		err = os.Rename(r.Path, r.Path+".1")
+	} else {
+		err = os.Remove(r.Path)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return r.open()
+}
+
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	if r.MaxSize > 0 && r.size > 0 && r.size+int64(len(p)) > r.MaxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+func (r *RotatingFile) Sync() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	return r.file.Sync()
+}
+
+func (r *RotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// CertHandler writes one JSON object per match.
+type CertHandler struct {
+	Out io.Writer
+}
+
+// New creates handler from |conf| with jsonl_output, jsonl_max_size (MB) and jsonl_max_backups.
+func New(conf []byte) (*CertHandler, error) {
+	var c struct {
+		Output     string `json:"jsonl_output"`
+		MaxSize    int64  `json:"jsonl_max_size"`
+		MaxBackups int    `json:"jsonl_max_backups"`
+	}
+	c.MaxBackups = 5
+	if err := json.Unmarshal(conf, &c); err != nil {
+		return nil, err
+	}
+	if c.Output == "" {
+		return nil, errors.New("jsonl_output is not set")
+	}
+	if c.Output == "-" {
+		return &CertHandler{Out: os.Stdout}, nil
+	}
+	return &CertHandler{Out: &RotatingFile{Path: c.Output,
+		MaxSize: c.MaxSize * 1024 * 1024, MaxBackups: c.MaxBackups}}, nil
+}
+
+func (s *CertHandler) Notify(m *models.Match) error {
+	return json.NewEncoder(s.Out).Encode(m)
+}
+
+// Flush syncs output file to disk.
+func (s *CertHandler) Flush() error {
+	if r, ok := s.Out.(*RotatingFile); ok {
+		return r.Sync()
+	}
+	return nil
+}
+
+func (s *CertHandler) Close() error {
+	if c, ok := s.Out.(io.Closer); ok && s.Out != os.Stdout {
+		return c.Close()
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package jsonl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+func tempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "ct_mon_jsonl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func write(t *testing.T, r *RotatingFile, lines ...string) {
+	for _, s := range lines {
+		if n, err := r.Write([]byte(s)); err != nil || n != len(s) {
+			t.Fatalf("Write %q: %d (%v)", s, n, err)
+		}
+	}
+}
+
+/* checks contents of |path| and its backups by suffix, "-" if a file must not exist */
+func checkFiles(t *testing.T, path string, want map[string]string) {
+	for suffix, w := range want {
+		data, err := ioutil.ReadFile(path + suffix)
+		got := string(data)
+		if os.IsNotExist(err) {
+			got = "-"
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if got != w {
+			t.Errorf("%s%s is %q, want %q", filepath.Base(path), suffix, got, w)
+		}
+	}
+}
+
+func TestRotation(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := filepath.Join(dir, "matches.jsonl")
+	r := &RotatingFile{Path: path, MaxSize: 10, MaxBackups: 2}
+	defer r.Close()
+
+	/* a line longer than MaxSize still goes to the empty file */
+	write(t, r, "0123456789ab\n", "a1\n", "a2\n", "a3\n", "b1\n", "c1\n")
+	checkFiles(t, path, map[string]string{"": "b1\nc1\n", ".1": "a1\na2\na3\n", ".2": "0123456789ab\n", ".3": "-"})
+
+	/* the oldest backup is dropped */
+	write(t, r, "d1\n", "e1\n")
+	checkFiles(t, path, map[string]string{"": "e1\n", ".1": "b1\nc1\nd1\n", ".2": "a1\na2\na3\n", ".3": "-"})
+}
+
+func TestRotationWithoutBackups(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := filepath.Join(dir, "matches.jsonl")
+	r := &RotatingFile{Path: path, MaxSize: 4}
+	defer r.Close()
+
+	write(t, r, "a1\n", "b1\n")
+	checkFiles(t, path, map[string]string{"": "b1\n", ".1": "-"})
+}
+
+func TestReopen(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := filepath.Join(dir, "matches.jsonl")
+
+	r := &RotatingFile{Path: path, MaxSize: 10, MaxBackups: 1}
+	write(t, r, "a1\n", "a2\n")
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	/* closed file is opened again on write */
+	write(t, r, "a3\n")
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	/* restart appends and counts the size of the existing file */
+	r = &RotatingFile{Path: path, MaxSize: 10, MaxBackups: 1}
+	defer r.Close()
+	write(t, r, "b1\n")
+	checkFiles(t, path, map[string]string{"": "b1\n", ".1": "a1\na2\na3\n"})
+
+	/* file moved away by external rotation is not reopened until the next rotation */
+	if err := os.Rename(path, path+".old"); err != nil {
+		t.Fatal(err)
+	}
+	write(t, r, "b2\n", "b3\n", "c1\n")
+	checkFiles(t, path, map[string]string{"": "c1\n", ".old": "b1\nb2\nb3\n"})
+}
+
+func TestNotify(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := filepath.Join(dir, "matches.jsonl")
+	conf, _ := json.Marshal(map[string]interface{}{"jsonl_output": path})
+	s, err := New(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fp := range []string{"ab12", "cd34"} {
+		if err := s.Notify(&models.Match{Fingerprint: fp}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	for _, want := range []string{"ab12", "cd34"} {
+		var m models.Match
+		if err := dec.Decode(&m); err != nil || m.Fingerprint != want {
+			t.Errorf("line %+v (%v), want fingerprint %s", m, err, want)
+		}
+	}
+	if dec.More() {
+		t.Error("more lines than matches")
+	}
+}
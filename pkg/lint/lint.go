@@ -0,0 +1,244 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/certificate-transparency/go/x509"
+)
+
+type Level string
+
+const (
+	Warn  Level = "warn"
+	Error Level = "error"
+)
+
+type Result struct {
+	Name    string `json:"name" bson:"name"`
+	Level   Level  `json:"level" bson:"level"`
+	Details string `json:"details" bson:"details"`
+}
+
+func (r Result) String() string {
+	return fmt.Sprintf("%s\t%s\t%s", r.Level, r.Name, r.Details)
+}
+
+type lintFunc func(c *x509.Certificate) []Result
+
+var lints = []lintFunc{
+	lintDNSNames,
+	lintCNInSANs,
+	lintSerial,
+	lintValidity,
+	lintKeyIdentifiers,
+	lintReservedIPs,
+}
+
+// Check runs all baseline requirements lints against |c|, precertificate TBS included.
+func Check(c *x509.Certificate) []Result {
+	var res []Result
+	for _, l := range lints {
+		res = append(res, l(c)...)
+	}
+	return res
+}
+
+// HasErrors returns true if any of |results| is an error.
+func HasErrors(results []Result) bool {
+	for _, r := range results {
+		if r.Level == Error {
+			return true
+		}
+	}
+	return false
+}
+
+func result(name string, level Level, format string, a ...interface{}) []Result {
+	return []Result{{Name: name, Level: level, Details: fmt.Sprintf(format, a...)}}
+}
+
+func validDNSName(name string) bool {
+	if name == "" || len(name) > 253 {
+		return false
+	}
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		if i == 0 && label == "*" && len(labels) > 2 {
+			continue
+		}
+		if label == "" || len(label) > 63 {
+			return false
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, ch := range label {
+			switch {
+			case ch >= 'a' && ch <= 'z':
+			case ch >= 'A' && ch <= 'Z':
+			case ch >= '0' && ch <= '9':
+			case ch == '-':
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func lintDNSNames(c *x509.Certificate) []Result {
+	var res []Result
+	for _, name := range c.DNSNames {
+		if !validDNSName(name) {
+			res = append(res, result("san_dns_name_invalid", Error, "invalid DNS name %q in SAN", name)...)
+		}
+	}
+	return res
+}
+
+func lintCNInSANs(c *x509.Certificate) []Result {
+	cn := c.Subject.CommonName
+	if cn == "" {
+		return nil
+	}
+	for _, name := range c.DNSNames {
+		if strings.EqualFold(name, cn) {
+			return nil
+		}
+	}
+	for _, ip := range c.IPAddresses {
+		if ip.String() == cn {
+			return nil
+		}
+	}
+	return result("cn_not_in_san", Error, "CN %q is not listed in SANs", cn)
+}
+
+func lintSerial(c *x509.Certificate) []Result {
+	if c.SerialNumber == nil {
+		return result("serial_missing", Error, "no serial number")
+	}
+	if c.SerialNumber.Sign() < 0 {
+		return result("serial_negative", Error, "serial number %s is negative", c.SerialNumber)
+	}
+	/* DER adds a leading zero octet when the high bit is set */
+	b := c.SerialNumber.Bytes()
+	octets := len(b)
+	if octets > 0 && b[0]&0x80 != 0 {
+		octets++
+	}
+	if octets > 20 {
+		return result("serial_too_long", Error, "serial number is %d octets, maximum is 20", octets)
+	}
+	return nil
+}
+
+type rawValidity struct {
+	NotBefore asn1.RawValue
+	NotAfter  asn1.RawValue
+}
+
+/* leading part of TBSCertificate, remaining fields are ignored by asn1 */
+type rawTBSValidity struct {
+	Raw          asn1.RawContent
+	Version      int `asn1:"optional,explicit,default:0,tag:0"`
+	SerialNumber asn1.RawValue
+	Signature    asn1.RawValue
+	Issuer       asn1.RawValue
+	Validity     rawValidity
+}
+
+/* RFC 5280 4.1.2.5: dates through 2049 as UTCTime, 2050 and later as GeneralizedTime */
+func checkTimeEncoding(field string, v asn1.RawValue, t time.Time) []Result {
+	value := string(v.Bytes)
+	switch v.Tag {
+	case asn1.TagUTCTime:
+		if t.Year() >= 2050 {
+			return result("validity_encoding", Error, "%s in %d encoded as UTCTime", field, t.Year())
+		}
+		if len(value) != 13 || !strings.HasSuffix(value, "Z") {
+			return result("validity_encoding", Error, "%s UTCTime %q is not YYMMDDHHMMSSZ", field, value)
+		}
+	case asn1.TagGeneralizedTime:
+		if t.Year() < 2050 {
+			return result("validity_encoding", Error, "%s in %d encoded as GeneralizedTime", field, t.Year())
+		}
+		if len(value) != 15 || !strings.HasSuffix(value, "Z") {
+			return result("validity_encoding", Error, "%s GeneralizedTime %q is not YYYYMMDDHHMMSSZ", field, value)
+		}
+	default:
+		return result("validity_encoding", Error, "%s has unexpected ASN.1 tag %d", field, v.Tag)
+	}
+	return nil
+}
+
+func lintValidity(c *x509.Certificate) []Result {
+	var res []Result
+	if c.NotAfter.Before(c.NotBefore) {
+		res = append(res, result("validity_inverted", Error, "NotAfter %v is before NotBefore %v", c.NotAfter, c.NotBefore)...)
+	}
+
+	raw := c.RawTBSCertificate
+	if len(raw) == 0 {
+		raw = c.Raw
+	}
+	var tbs rawTBSValidity
+	if _, err := asn1.Unmarshal(raw, &tbs); err != nil {
+		return append(res, result("validity_encoding", Error, "can't parse TBSCertificate (%v)", err)...)
+	}
+	res = append(res, checkTimeEncoding("NotBefore", tbs.Validity.NotBefore, c.NotBefore)...)
+	res = append(res, checkTimeEncoding("NotAfter", tbs.Validity.NotAfter, c.NotAfter)...)
+	return res
+}
+
+func lintKeyIdentifiers(c *x509.Certificate) []Result {
+	var res []Result
+	if len(c.SubjectKeyId) == 0 {
+		level := Warn
+		if c.IsCA {
+			level = Error
+		}
+		res = append(res, result("ski_missing", level, "no SubjectKeyIdentifier extension")...)
+	}
+	if len(c.AuthorityKeyId) == 0 && !bytes.Equal(c.RawIssuer, c.RawSubject) {
+		res = append(res, result("aki_missing", Error, "no AuthorityKeyIdentifier extension")...)
+	}
+	return res
+}
+
+var reservedNets []*net.IPNet
+
+func init() {
+	for _, cidr := range []string{
+		"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8",
+		"169.254.0.0/16", "172.16.0.0/12", "192.0.0.0/24", "192.0.2.0/24",
+		"192.168.0.0/16", "198.18.0.0/15", "198.51.100.0/24", "203.0.113.0/24",
+		"224.0.0.0/4", "240.0.0.0/4",
+		"::/128", "::1/128", "64:ff9b:1::/48", "100::/64", "2001::/23",
+		"2001:db8::/32", "fc00::/7", "fe80::/10", "ff00::/8",
+	} {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		reservedNets = append(reservedNets, n)
+	}
+}
+
+func lintReservedIPs(c *x509.Certificate) []Result {
+	var res []Result
+	for _, ip := range c.IPAddresses {
+		for _, n := range reservedNets {
+			if n.Contains(ip) {
+				res = append(res, result("san_ip_reserved", Error, "reserved IP address %s (%s) in SAN", ip, n)...)
+				break
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,186 @@
+package lint
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	stdx509 "crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"net"
+	"testing"
+
+	"github.com/google/certificate-transparency/go/x509"
+)
+
+var (
+	oidSAN             = asn1.ObjectIdentifier{2, 5, 29, 17}
+	oidSKI             = asn1.ObjectIdentifier{2, 5, 29, 14}
+	oidAKI             = asn1.ObjectIdentifier{2, 5, 29, 35}
+	oidBasicConstr     = asn1.ObjectIdentifier{2, 5, 29, 19}
+	oidECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+)
+
+/* crafted by hand, crypto/x509 refuses to create most of the broken certificates */
+type testCert struct {
+	serial    *big.Int
+	cn        string
+	dnsNames  []string
+	ips       []net.IP
+	notBefore asn1.RawValue
+	notAfter  asn1.RawValue
+	ski       bool
+	aki       bool
+	ca        bool
+}
+
+func utcTime(s string) asn1.RawValue {
+	return asn1.RawValue{Tag: asn1.TagUTCTime, Bytes: []byte(s)}
+}
+
+func generalizedTime(s string) asn1.RawValue {
+	return asn1.RawValue{Tag: asn1.TagGeneralizedTime, Bytes: []byte(s)}
+}
+
+func cleanCert() *testCert {
+	return &testCert{serial: big.NewInt(0x1234567), cn: "www.example.com",
+		dnsNames: []string{"www.example.com", "*.example.com"}, ips: []net.IP{net.ParseIP("8.8.8.8")},
+		notBefore: utcTime("160301000000Z"), notAfter: utcTime("170301000000Z"), ski: true, aki: true}
+}
+
+func name(cn string) asn1.RawValue {
+	der, err := asn1.Marshal(pkix.Name{CommonName: cn}.ToRDNSequence())
+	if err != nil {
+		panic(err)
+	}
+	return asn1.RawValue{FullBytes: der}
+}
+
+func extension(id asn1.ObjectIdentifier, v interface{}) pkix.Extension {
+	der, err := asn1.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return pkix.Extension{Id: id, Value: der}
+}
+
+func (tc *testCert) der(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spki, err := stdx509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sans []asn1.RawValue
+	for _, n := range tc.dnsNames {
+		sans = append(sans, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, Bytes: []byte(n)})
+	}
+	for _, ip := range tc.ips {
+		if v4 := ip.To4(); v4 != nil {
+			ip = v4
+		}
+		sans = append(sans, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 7, Bytes: ip})
+	}
+	exts := []pkix.Extension{extension(oidBasicConstr, struct {
+		IsCA bool `asn1:"optional"`
+	}{tc.ca})}
+	if len(sans) > 0 {
+		exts = append(exts, extension(oidSAN, sans))
+	}
+	if tc.ski {
+		exts = append(exts, extension(oidSKI, []byte{1, 2, 3, 4}))
+	}
+	if tc.aki {
+		exts = append(exts, extension(oidAKI, struct {
+			Id []byte `asn1:"optional,tag:0"`
+		}{[]byte{5, 6, 7, 8}}))
+	}
+
+	alg := pkix.AlgorithmIdentifier{Algorithm: oidECDSAWithSHA256}
+	tbs := struct {
+		Version    int `asn1:"optional,explicit,default:0,tag:0"`
+		Serial     *big.Int
+		Signature  pkix.AlgorithmIdentifier
+		Issuer     asn1.RawValue
+		Validity   []asn1.RawValue
+		Subject    asn1.RawValue
+		PublicKey  asn1.RawValue
+		Extensions []pkix.Extension `asn1:"optional,explicit,tag:3"`
+	}{2, tc.serial, alg, name("Test CA"), []asn1.RawValue{tc.notBefore, tc.notAfter}, name(tc.cn),
+		asn1.RawValue{FullBytes: spki}, exts}
+	der, err := asn1.Marshal(struct {
+		TBS       interface{}
+		Algorithm pkix.AlgorithmIdentifier
+		Signature asn1.BitString
+	}{tbs, alg, asn1.BitString{Bytes: []byte{0}, BitLength: 8}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(c *testCert)
+		/* expected findings, none for the clean certificate */
+		want []string
+	}{
+		{"clean", func(c *testCert) {}, nil},
+		{"san_dns_name_invalid", func(c *testCert) { c.dnsNames = append(c.dnsNames, "bad_name.example.com") },
+			[]string{"san_dns_name_invalid"}},
+		{"san_dns_name_wildcard_tld", func(c *testCert) { c.dnsNames = append(c.dnsNames, "*.com") },
+			[]string{"san_dns_name_invalid"}},
+		{"cn_not_in_san", func(c *testCert) { c.cn = "mail.example.com" }, []string{"cn_not_in_san"}},
+		{"cn_ip_in_san", func(c *testCert) { c.cn = "8.8.8.8" }, nil},
+		{"serial_negative", func(c *testCert) { c.serial = big.NewInt(-5) }, []string{"serial_negative"}},
+		{"serial_too_long", func(c *testCert) { c.serial = new(big.Int).Lsh(big.NewInt(1), 159) },
+			[]string{"serial_too_long"}},
+		{"serial_20_octets", func(c *testCert) { c.serial = new(big.Int).Lsh(big.NewInt(1), 158) }, nil},
+		{"validity_inverted", func(c *testCert) { c.notBefore, c.notAfter = c.notAfter, c.notBefore },
+			[]string{"validity_inverted"}},
+		{"validity_generalized_before_2050", func(c *testCert) { c.notAfter = generalizedTime("20490301000000Z") },
+			[]string{"validity_encoding"}},
+		{"validity_generalized_2050", func(c *testCert) { c.notAfter = generalizedTime("20500301000000Z") }, nil},
+		{"validity_utctime_no_seconds", func(c *testCert) { c.notBefore = utcTime("1603010000Z") },
+			[]string{"validity_encoding"}},
+		{"ski_missing", func(c *testCert) { c.ski = false }, []string{"ski_missing"}},
+		{"ski_missing_ca", func(c *testCert) { c.ski, c.ca = false, true }, []string{"ski_missing"}},
+		{"aki_missing", func(c *testCert) { c.aki = false }, []string{"aki_missing"}},
+		{"san_ip_reserved", func(c *testCert) { c.ips = append(c.ips, net.ParseIP("10.1.2.3"), net.ParseIP("fe80::1")) },
+			[]string{"san_ip_reserved", "san_ip_reserved"}},
+	}
+
+	for _, tt := range tests {
+		tc := cleanCert()
+		tt.change(tc)
+		/* the scanner passes certificates with non-fatal errors on */
+		c, err := x509.ParseCertificate(tc.der(t))
+		if _, ok := err.(x509.NonFatalErrors); err != nil && !ok {
+			t.Errorf("%s: can't parse certificate (%v)", tt.name, err)
+			continue
+		}
+		res := Check(c)
+		var got []string
+		for _, r := range res {
+			got = append(got, r.Name)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, res, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: got %v, want %v", tt.name, res, tt.want)
+				break
+			}
+		}
+		if HasErrors(res) != (tt.name != "ski_missing" && len(tt.want) > 0) {
+			t.Errorf("%s: HasErrors is %v for %v", tt.name, HasErrors(res), res)
+		}
+	}
+}
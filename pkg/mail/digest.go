@@ -0,0 +1,80 @@
+package mail
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+func (s *CertHandler) collect(m *models.Match, done func(error)) error {
+	s.mu.Lock()
+	s.pending = append(s.pending, m)
+	s.acks = append(s.acks, done)
+	full := s.DigestSize > 0 && len(s.pending) >= s.DigestSize
+	if !full && s.timer == nil && s.DigestWindow > 0 {
+		s.timer = time.AfterFunc(time.Duration(s.DigestWindow)*time.Second, func() {
+			if err := s.flush(); err != nil {
+				log.Printf("Error sending digest email (%v)", err)
+			}
+		})
+	}
+	s.mu.Unlock()
+
+	if full {
+		return s.flush()
+	}
+	return nil
+}
+
+/* sends collected matches as one email and acks them */
+func (s *CertHandler) flush() error {
+	s.mu.Lock()
+	matches, acks := s.pending, s.acks
+	s.pending, s.acks = nil, nil
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	s.mu.Unlock()
+
+	if len(matches) == 0 {
+		return nil
+	}
+
+	msg, err := s.DigestMessage(matches)
+	if err == nil {
+		err = s.send(msg)
+	}
+	for _, done := range acks {
+		if done != nil {
+			done(err)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%d matches not sent (%v)", len(matches), err)
+	}
+	return nil
+}
+
+// DigestMessage renders digest email about |matches|.
+func (s *CertHandler) DigestMessage(matches []*models.Match) ([]byte, error) {
+	data := &DigestData{Count: len(matches)}
+	for _, m := range matches {
+		data.Matches = append(data.Matches, newMatchData(m))
+	}
+	subject, err := execute(s.tpl.digestSubject, data)
+	if err != nil {
+		return nil, err
+	}
+	text, err := execute(s.tpl.digestText, data)
+	if err != nil {
+		return nil, err
+	}
+	html, err := execute(s.tpl.digestHTML, data)
+	if err != nil {
+		return nil, err
+	}
+	return s.compose(subject, text, html)
+}
@@ -0,0 +1,28 @@
+package mail
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+// HealthMessage renders email about log health change |h|.
+func (s *CertHandler) HealthMessage(h *models.LogHealth) ([]byte, error) {
+	title := h.Title()
+	text := fmt.Sprintf("%s\n\nLog: %s\nProblem: %s\nSince: %s\n\n%s\n", title, h.Log, h.Problem,
+		h.Since.Format("2006-01-02 15:04:05 MST"), h.Message)
+	body := fmt.Sprintf("<!DOCTYPE html>\n<html>\n<body>\n<h3>%s</h3>\n<p>Log: %s<br>Problem: %s<br>Since: %s</p>\n<p>%s</p>\n</body>\n</html>\n",
+		html.EscapeString(title), html.EscapeString(h.Log), html.EscapeString(h.Problem),
+		h.Since.Format("2006-01-02 15:04:05 MST"), html.EscapeString(h.Message))
+	return s.compose(title, text, body)
+}
+
+/* log health is never collected into digests */
+func (s *CertHandler) NotifyHealth(h *models.LogHealth) error {
+	msg, err := s.HealthMessage(h)
+	if err != nil {
+		return err
+	}
+	return s.send(msg)
+}
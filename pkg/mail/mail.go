@@ -1,184 +1,167 @@
 package mail
 
 import (
-	"bytes"
-	"crypto/sha256"
-	"encoding/hex"
-	"encoding/pem"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
-	"strings"
-
-	"html/template"
 	"net/smtp"
+	"sync"
+	"time"
 
 	"github.com/kyprizel/ct_mon/models"
 )
 
-const mail_tpl = `From: {{ .From }}
-To: {{ .To }}
-Subject: {{ .Subject }}
-MIME-version: 1.0
-Content-Type: multipart/alternative; boundary="===============7660463594043036259==
-
---===============7660463594043036259==
-Content-Type: text/plain
-MIME-Version: 1.0
-Content-Transfer-Encoding: 8bit
-
-New certificate found1
-
-Log Index: {{ .Index }}
-SHA256:</b> {{ .Hashsum }}
-CN: {{ .CN }}
-Issuer: {{ .Issuer }}
-Details: https://crt.sh/?sha256={{ .Hashsum }}
-
-SANs:
-    {{range .SAN}}
-    {{ . }}
-    {{end}}
-
-{{ .Pem }}
-
-
---===============7660463594043036259==
-Content-Type: text/html; charset="UTF-8"
-MIME-Version: 1.0
-Content-Transfer-Encoding: 8bit
+type CertHandler struct {
+	Emails   []string `json:"notify_persons"`
+	Host     string   `json:"smtp_host"`
+	Port     int      `json:"smtp_port"`
+	User     string   `json:"smtp_user"`
+	Password string   `json:"smtp_password"`
+	From     string   `json:"smtp_from"`
+	/* auto, none, starttls or tls */
+	TLSMode    string `json:"smtp_tls"`
+	CAFile     string `json:"smtp_ca_file"`
+	ServerName string `json:"smtp_server_name"`
+	/* plain, login, cram-md5 or none, plain if user is set by default */
+	AuthMech string `json:"smtp_auth"`
+	/* S/MIME signing certificate and key PEM files, certificates to encrypt to */
+	SMIMECert    string   `json:"smime_cert"`
+	SMIMEKey     string   `json:"smime_key"`
+	SMIMEEncrypt []string `json:"smime_encrypt_certs"`
+	/* OpenPGP armored secret key file, armored public keyring to encrypt to */
+	PGPKey        string `json:"pgp_key"`
+	PGPPassphrase string `json:"pgp_passphrase"`
+	PGPEncrypt    string `json:"pgp_encrypt_keys"`
+	/* subject templates, e.g. "New certificate for {{ .CN }}" */
+	Subj         string            `json:"smtp_subject"`
+	RuleSubjects map[string]string `json:"rule_subjects"`
+	DigestSubj   string            `json:"digest_subject"`
+	/* body template files, built-in templates are used if not set */
+	TextTemplate       string `json:"text_template"`
+	HTMLTemplate       string `json:"html_template"`
+	DigestTextTemplate string `json:"digest_text_template"`
+	DigestHTMLTemplate string `json:"digest_html_template"`
+	/* digest of matches collected for this number of seconds, 0 sends every match */
+	DigestWindow int `json:"digest_window"`
+	/* digest is sent earlier when this number of matches is collected */
+	DigestSize int `json:"digest_size"`
+	/* matches of this or higher severity are sent immediately */
+	ImmediateSeverity models.Severity `json:"immediate_severity"`
+
+	tpl       *templates
+	tlsConfig *tls.Config
+	auth      smtp.Auth
+	securer   Securer
+	mu        sync.Mutex
+	pending   []*models.Match
+	/* called with the digest send result, nil for matches given to Notify */
+	acks  []func(error)
+	timer *time.Timer
+}
 
-<!DOCTYPE html>
-<html>
-    <head>
-        <meta charset="UTF-8">
-        <title>Certificate Transparency notification</title>
-    </head>
-    <body>
-        <div>
-        <h2>New certificate found</h2>
-        <table>
-         <tr><th align="left">Log Index:</th><td>{{ .Index }}</td></tr>
-         <tr><th align="left">SHA256:</th><td>{{ .Hashsum }}</td></tr>
-         <tr><th align="left">CN:</th><td>{{ .CN }}</td></tr>
-         <tr><th align="left">Issuer:</th><td>{{ .Issuer }}</td></tr>
-         <tr><th align="left"><a href="https://crt.sh/?sha256={{ .Hashsum }}">View details</a></th><td></td></tr>
-         <tr><th align="left">SANs:</th><td></td></tr>
-         <tr>
-            <td colspan="2" align="left">
-            <ol>{{range .SAN}}<li>{{ . }}</li>{{end}}</ol>
-            </td>
-        </tr>
-        <tr>
-        <td colspan="2">
-        <pre>
-        {{ .Pem }}
-        </pre>
-        </td>
-        </tr>
-    </body>
-</html>
+// New creates handler from |conf| with the same keys as top-level SMTP config.
+func New(conf []byte) (*CertHandler, error) {
+	s := &CertHandler{Port: 25, Subj: defaultSubject, DigestSubj: defaultDigestSubject}
+	if err := json.Unmarshal(conf, s); err != nil {
+		return nil, err
+	}
+	if len(s.Emails) == 0 || s.Host == "" || s.From == "" {
+		return nil, errors.New("notify_persons, smtp_host and smtp_from are required")
+	}
+	if s.ImmediateSeverity != "" && s.ImmediateSeverity.Level() == 0 {
+		return nil, fmt.Errorf("unknown severity %q", s.ImmediateSeverity)
+	}
+	if err := s.initSMTP(); err != nil {
+		return nil, err
+	}
+	if err := s.loadTemplates(); err != nil {
+		return nil, err
+	}
+	var err error
+	switch {
+	case s.SMIMECert != "" && s.PGPKey != "":
+		return nil, errors.New("either S/MIME or OpenPGP can be used")
+	case s.SMIMECert != "":
+		s.securer, err = NewSMIME(s.SMIMECert, s.SMIMEKey, s.SMIMEEncrypt)
+	case s.PGPKey != "":
+		s.securer, err = NewPGP(s.PGPKey, s.PGPPassphrase, s.PGPEncrypt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
 
+/* digest matches are only collected, nil means they are not sent yet */
+func (s *CertHandler) Notify(m *models.Match) error {
+	if !s.digested(m) {
+		return s.notifyOne(m)
+	}
+	return s.collect(m, nil)
+}
 
---===============7660463594043036259==--
-`
+// Hold sends |m| or collects it to digest, |done| gets the result once it is actually sent.
+func (s *CertHandler) Hold(m *models.Match, done func(error)) {
+	if !s.digested(m) {
+		done(s.notifyOne(m))
+		return
+	}
+	/* a full digest acks its matches while flushing */
+	s.collect(m, done)
+}
 
-type CertHandler struct {
-	Emails   []string
-	Host     string
-	Port     int
-	User     string
-	Password string
-	From     string
-	Subj     string
+func (s *CertHandler) digested(m *models.Match) bool {
+	if s.DigestWindow <= 0 && s.DigestSize <= 0 {
+		return false
+	}
+	return s.ImmediateSeverity == "" || m.Severity.Level() < s.ImmediateSeverity.Level()
 }
 
-func (s *CertHandler) HandleEvents(ch chan models.MonEvent) {
-	for {
-		ev := <-ch
-		entry := *ev.LogEntry
-		switch ev.Type {
-		case models.CT_CERT:
-			pemCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: entry.X509Cert.Raw})
-			hasher := sha256.New()
-			hasher.Write(entry.X509Cert.Raw)
-			sha := hex.EncodeToString(hasher.Sum(nil))
-			t, _ := template.New("notification").Parse(mail_tpl)
-			data := struct {
-				From    string
-				Subject string
-				To      string
-				Index   int64
-				CN      string
-				SAN     []string
-				Issuer  string
-				Pem     string
-				Hashsum string
-			}{
-				From:    s.From,
-				To:      strings.Join([]string(s.Emails), ","),
-				Subject: s.Subj,
-				Index:   entry.Index,
-				CN:      entry.X509Cert.Subject.CommonName,
-				SAN:     entry.X509Cert.DNSNames,
-				Issuer:  entry.X509Cert.Issuer.CommonName,
-				Pem:     string(pemCert),
-				Hashsum: sha,
-			}
-			buf := new(bytes.Buffer)
-			t.Execute(buf, data)
+// Message renders email about single match |m|.
+func (s *CertHandler) Message(m *models.Match) ([]byte, error) {
+	data := newMatchData(m)
+	subject, err := execute(s.tpl.subjectFor(m), data)
+	if err != nil {
+		return nil, err
+	}
+	text, err := execute(s.tpl.text, data)
+	if err != nil {
+		return nil, err
+	}
+	html, err := execute(s.tpl.html, data)
+	if err != nil {
+		return nil, err
+	}
+	return s.compose(subject, text, html)
+}
 
-			var auth smtp.Auth
-			if s.User != "" && s.Password != "" {
-				auth = smtp.PlainAuth("", s.User, s.Password, s.Host)
-			}
+func (s *CertHandler) compose(subject string, text string, html string) ([]byte, error) {
+	body, err := Alternative(text, html)
+	if err != nil {
+		return nil, err
+	}
+	if s.securer != nil {
+		if body, err = s.securer.Secure(body); err != nil {
+			return nil, err
+		}
+	}
+	return BuildMessage(s.From, s.Emails, subject, body), nil
+}
 
-			/* XXX: handle errors */
-			err := smtp.SendMail(fmt.Sprintf("%s:%d", s.Host, s.Port), auth, s.From, s.Emails, buf.Bytes())
-			if err != nil {
-				log.Print("Error sending email")
-			}
-		case models.CT_PRECERT:
-			pemCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: entry.Precert.TBSCertificate.Raw})
-			hasher := sha256.New()
-			hasher.Write(entry.Precert.TBSCertificate.Raw)
-			sha := hex.EncodeToString(hasher.Sum(nil))
-			t, _ := template.New("notification").Parse(mail_tpl)
-			data := struct {
-				From    string
-				Subject string
-				To      string
-				Index   int64
-				CN      string
-				SAN     []string
-				Issuer  string
-				Pem     string
-				Hashsum string
-			}{
-				From:    s.From,
-				To:      strings.Join([]string(s.Emails), ","),
-				Subject: s.Subj,
-				Index:   entry.Index,
-				CN:      entry.Precert.TBSCertificate.Subject.CommonName,
-				SAN:     entry.Precert.TBSCertificate.DNSNames,
-				Issuer:  entry.Precert.TBSCertificate.Issuer.CommonName,
-				Pem:     string(pemCert),
-				Hashsum: sha,
-			}
-			buf := new(bytes.Buffer)
-			t.Execute(buf, data)
+func (s *CertHandler) notifyOne(m *models.Match) error {
+	msg, err := s.Message(m)
+	if err != nil {
+		return err
+	}
+	return s.send(msg)
+}
 
-			var auth smtp.Auth
-			if s.User != "" && s.Password != "" {
-				auth = smtp.PlainAuth("", s.User, s.Password, s.Host)
-			}
+// Flush sends digest of collected matches.
+func (s *CertHandler) Flush() error {
+	return s.flush()
+}
 
-			/* XXX: handle errors */
-			err := smtp.SendMail(fmt.Sprintf("%s:%d", s.Host, s.Port), auth, s.From, s.Emails, buf.Bytes())
-			if err != nil {
-				log.Print("Error sending email")
-			}
-		case models.CT_QUIT:
-			break
-		}
-	}
+func (s *CertHandler) Close() error {
+	return s.flush()
 }
@@ -0,0 +1,152 @@
+package mail
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+)
+
+var headerSanitizer = strings.NewReplacer("\r", " ", "\n", " ")
+
+/* header value without line breaks, RFC 2047 encoded if not ASCII */
+func encodeHeader(v string) string {
+	return mime.QEncoding.Encode("utf-8", headerSanitizer.Replace(v))
+}
+
+func encodeAddress(addr string) string {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return headerSanitizer.Replace(addr)
+	}
+	return a.String()
+}
+
+func messageID(from string) string {
+	var b [12]byte
+	rand.Read(b[:])
+	domain := "localhost"
+	if i := strings.LastIndex(from, "@"); i >= 0 {
+		domain = strings.Trim(from[i+1:], "> ")
+	}
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b[:]), domain)
+}
+
+// Part is MIME entity in canonical form with CRLF line breaks.
+type Part struct {
+	Header textproto.MIMEHeader
+	Body   []byte
+}
+
+// Bytes returns headers and body of |p|, what signatures are computed over.
+func (p *Part) Bytes() []byte {
+	var buf bytes.Buffer
+	p.writeHeader(&buf)
+	buf.WriteString("\r\n")
+	buf.Write(p.Body)
+	return buf.Bytes()
+}
+
+func (p *Part) writeHeader(buf *bytes.Buffer) {
+	keys := make([]string, 0, len(p.Header))
+	for k := range p.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range p.Header[k] {
+			fmt.Fprintf(buf, "%s: %s\r\n", k, v)
+		}
+	}
+}
+
+func quotedPrintable(contentType string, body []byte) (*Part, error) {
+	var buf bytes.Buffer
+	qp := quotedprintable.NewWriter(&buf)
+	if _, err := qp.Write(body); err != nil {
+		return nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, err
+	}
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Type", contentType)
+	h.Set("Content-Transfer-Encoding", "quoted-printable")
+	return &Part{Header: h, Body: buf.Bytes()}, nil
+}
+
+/* base64 with lines of 76 chars */
+func base64Part(header textproto.MIMEHeader, data []byte) *Part {
+	enc := base64.StdEncoding.EncodeToString(data)
+	var buf bytes.Buffer
+	for len(enc) > 76 {
+		buf.WriteString(enc[:76])
+		buf.WriteString("\r\n")
+		enc = enc[76:]
+	}
+	buf.WriteString(enc)
+	buf.WriteString("\r\n")
+	header.Set("Content-Transfer-Encoding", "base64")
+	return &Part{Header: header, Body: buf.Bytes()}
+}
+
+// Multipart joins |parts| into multipart/|subtype| entity with extra Content-Type |params|.
+func Multipart(subtype string, params string, parts ...*Part) (*Part, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for _, p := range parts {
+		pw, err := w.CreatePart(p.Header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := pw.Write(p.Body); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Type", fmt.Sprintf("multipart/%s; boundary=%q%s", subtype, w.Boundary(), params))
+	return &Part{Header: h, Body: buf.Bytes()}, nil
+}
+
+// Alternative returns multipart/alternative entity with quoted-printable |text| and |html| bodies.
+func Alternative(text string, html string) (*Part, error) {
+	textPart, err := quotedPrintable("text/plain; charset=utf-8", []byte(text))
+	if err != nil {
+		return nil, err
+	}
+	htmlPart, err := quotedPrintable("text/html; charset=utf-8", []byte(html))
+	if err != nil {
+		return nil, err
+	}
+	return Multipart("alternative", "", textPart, htmlPart)
+}
+
+// BuildMessage returns RFC 5322 message with |body| entity.
+func BuildMessage(from string, to []string, subject string, body *Part) []byte {
+	var buf bytes.Buffer
+
+	recipients := make([]string, 0, len(to))
+	for _, addr := range to {
+		recipients = append(recipients, encodeAddress(addr))
+	}
+	fmt.Fprintf(&buf, "From: %s\r\n", encodeAddress(from))
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(recipients, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", encodeHeader(subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: %s\r\n", messageID(from))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	buf.Write(body.Bytes())
+	return buf.Bytes()
+}
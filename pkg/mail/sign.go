@@ -0,0 +1,191 @@
+package mail
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/textproto"
+	"os"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/smallstep/pkcs7"
+)
+
+/* package global in pkcs7, nothing else in ct_mon encrypts with it */
+func init() {
+	pkcs7.ContentEncryptionAlgorithm = pkcs7.EncryptionAlgorithmAES256CBC
+}
+
+// Securer signs and optionally encrypts message body.
+type Securer interface {
+	Secure(body *Part) (*Part, error)
+}
+
+type smime struct {
+	cert       *x509.Certificate
+	key        crypto.PrivateKey
+	recipients []*x509.Certificate
+}
+
+// NewSMIME loads signing certificate and key from PEM files, |recipients| are PEM files
+// with certificates to encrypt to, no encryption if empty.
+func NewSMIME(certFile string, keyFile string, recipients []string) (Securer, error) {
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	s := &smime{key: pair.PrivateKey}
+	if s.cert, err = x509.ParseCertificate(pair.Certificate[0]); err != nil {
+		return nil, err
+	}
+	for _, file := range recipients {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+			c, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", file, err)
+			}
+			s.recipients = append(s.recipients, c)
+		}
+	}
+	return s, nil
+}
+
+/* RFC 5751 detached signature, then enveloped data if there are recipients */
+func (s *smime) Secure(body *Part) (*Part, error) {
+	content := body.Bytes()
+	sd, err := pkcs7.NewSignedData(content)
+	if err != nil {
+		return nil, err
+	}
+	sd.SetDigestAlgorithm(pkcs7.OIDDigestAlgorithmSHA256)
+	if err := sd.AddSigner(s.cert, s.key, pkcs7.SignerInfoConfig{}); err != nil {
+		return nil, err
+	}
+	sd.Detach()
+	sig, err := sd.Finish()
+	if err != nil {
+		return nil, err
+	}
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Type", `application/pkcs7-signature; name="smime.p7s"`)
+	h.Set("Content-Disposition", `attachment; filename="smime.p7s"`)
+	signed, err := Multipart("signed", `; protocol="application/pkcs7-signature"; micalg=sha-256`,
+		body, base64Part(h, sig))
+	if err != nil || len(s.recipients) == 0 {
+		return signed, err
+	}
+
+	enveloped, err := pkcs7.Encrypt(signed.Bytes(), s.recipients)
+	if err != nil {
+		return nil, err
+	}
+	h = make(textproto.MIMEHeader)
+	h.Set("Content-Type", `application/pkcs7-mime; smime-type=enveloped-data; name="smime.p7m"`)
+	h.Set("Content-Disposition", `attachment; filename="smime.p7m"`)
+	return base64Part(h, enveloped), nil
+}
+
+type pgp struct {
+	signer     *openpgp.Entity
+	recipients openpgp.EntityList
+	config     *packet.Config
+}
+
+func readKeyRing(file string) (openpgp.EntityList, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return openpgp.ReadArmoredKeyRing(f)
+}
+
+// NewPGP loads armored secret key from |keyFile|, |recipients| is armored public keyring
+// to encrypt to, no encryption if empty.
+func NewPGP(keyFile string, passphrase string, recipients string) (Securer, error) {
+	keys, err := readKeyRing(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	s := &pgp{config: &packet.Config{DefaultHash: crypto.SHA256}}
+	for _, e := range keys {
+		if e.PrivateKey != nil {
+			s.signer = e
+			break
+		}
+	}
+	if s.signer == nil {
+		return nil, errors.New("no secret key in " + keyFile)
+	}
+	/* only encrypted keys are decrypted */
+	if err := s.signer.DecryptPrivateKeys([]byte(passphrase)); err != nil {
+		return nil, err
+	}
+	if recipients != "" {
+		if s.recipients, err = readKeyRing(recipients); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+/* RFC 3156 multipart/signed, or multipart/encrypted signed and encrypted at once */
+func (s *pgp) Secure(body *Part) (*Part, error) {
+	content := body.Bytes()
+	if len(s.recipients) == 0 {
+		var sig bytes.Buffer
+		if err := openpgp.ArmoredDetachSign(&sig, s.signer, bytes.NewReader(content), s.config); err != nil {
+			return nil, err
+		}
+		sig.WriteString("\r\n")
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Type", `application/pgp-signature; name="signature.asc"`)
+		return Multipart("signed", `; protocol="application/pgp-signature"; micalg=pgp-sha256`,
+			body, &Part{Header: h, Body: crlf(sig.Bytes())})
+	}
+
+	var enc bytes.Buffer
+	aw, err := armor.Encode(&enc, "PGP MESSAGE", nil)
+	if err != nil {
+		return nil, err
+	}
+	w, err := openpgp.Encrypt(aw, s.recipients, s.signer, nil, s.config)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(content); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	if err := aw.Close(); err != nil {
+		return nil, err
+	}
+	enc.WriteString("\r\n")
+
+	control := make(textproto.MIMEHeader)
+	control.Set("Content-Type", "application/pgp-encrypted")
+	data := make(textproto.MIMEHeader)
+	data.Set("Content-Type", `application/octet-stream; name="encrypted.asc"`)
+	return Multipart("encrypted", `; protocol="application/pgp-encrypted"`,
+		&Part{Header: control, Body: []byte("Version: 1\r\n")},
+		&Part{Header: data, Body: crlf(enc.Bytes())})
+}
+
+/* armor uses bare LF */
+func crlf(b []byte) []byte {
+	return bytes.Replace(bytes.Replace(b, []byte("\r\n"), []byte("\n"), -1), []byte("\n"), []byte("\r\n"), -1)
+}
@@ -0,0 +1,294 @@
+package mail
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"mime"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/smallstep/pkcs7"
+)
+
+func tempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "ct_mon_sign")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func writeFile(t *testing.T, dir string, name string, data []byte) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func testBody(t *testing.T) *Part {
+	body, err := Alternative("text\r\n", "<p>html</p>\r\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+/* raw entities of multipart |p|, with their headers as they were signed */
+func splitMultipart(t *testing.T, p *Part, subtype string) [][]byte {
+	mediaType, params, err := mime.ParseMediaType(p.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/"+subtype {
+		t.Fatalf("Content-Type %q, want multipart/%s (%v)", p.Header.Get("Content-Type"), subtype, err)
+	}
+	sections := bytes.Split(p.Body, []byte("--"+params["boundary"]))
+	if len(sections) < 3 || len(sections[0]) != 0 || string(sections[len(sections)-1]) != "--\r\n" {
+		t.Fatalf("malformed multipart body\n%s", p.Body)
+	}
+	var parts [][]byte
+	for _, s := range sections[1 : len(sections)-1] {
+		parts = append(parts, bytes.TrimSuffix(bytes.TrimPrefix(s, []byte("\r\n")), []byte("\r\n")))
+	}
+	return parts
+}
+
+func parsePart(t *testing.T, raw []byte) *Part {
+	r := bufio.NewReader(bytes.NewReader(raw))
+	h, err := textproto.NewReader(r).ReadMIMEHeader()
+	if err != nil {
+		t.Fatalf("invalid entity (%v)\n%s", err, raw)
+	}
+	body, _ := ioutil.ReadAll(r)
+	return &Part{Header: h, Body: body}
+}
+
+func decodeBase64(t *testing.T, p *Part) []byte {
+	if enc := p.Header.Get("Content-Transfer-Encoding"); enc != "base64" {
+		t.Fatalf("Content-Transfer-Encoding %q", enc)
+	}
+	data, err := base64.StdEncoding.DecodeString(string(bytes.Replace(p.Body, []byte("\r\n"), nil, -1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+/* self-signed RSA certificate and key PEM files */
+func smimeCert(t *testing.T, dir string, name string) (*x509.Certificate, *rsa.PrivateKey, string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl := &x509.Certificate{
+		SerialNumber:   big.NewInt(1),
+		Subject:        pkix.Name{CommonName: name},
+		EmailAddresses: []string{name},
+		NotBefore:      time.Now().Add(-time.Hour),
+		NotAfter:       time.Now().Add(time.Hour),
+		KeyUsage:       x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageEmailProtection},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	certFile := writeFile(t, dir, name+".crt", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyFile := writeFile(t, dir, name+".key", pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	return cert, key, certFile, keyFile
+}
+
+/* checks |signed| is multipart/signed |body| with valid detached signature by |cert| */
+func checkSMIMESignature(t *testing.T, signed *Part, body *Part, cert *x509.Certificate) {
+	parts := splitMultipart(t, signed, "signed")
+	if len(parts) != 2 {
+		t.Fatalf("%d parts in multipart/signed", len(parts))
+	}
+	if !bytes.Equal(parts[0], body.Bytes()) {
+		t.Errorf("signed entity differs from body\n%s", parts[0])
+	}
+	sigPart := parsePart(t, parts[1])
+	if ct := sigPart.Header.Get("Content-Type"); ct != `application/pkcs7-signature; name="smime.p7s"` {
+		t.Errorf("signature Content-Type %q", ct)
+	}
+	p7, err := pkcs7.Parse(decodeBase64(t, sigPart))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p7.Content = parts[0]
+	if err := p7.Verify(); err != nil {
+		t.Errorf("invalid signature (%v)", err)
+	}
+	if signer := p7.GetOnlySigner(); signer == nil || !signer.Equal(cert) {
+		t.Error("signed by other certificate")
+	}
+}
+
+func TestSMIME(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	cert, _, certFile, keyFile := smimeCert(t, dir, "ct@example.com")
+	rcptCert, rcptKey, rcptFile, _ := smimeCert(t, dir, "soc@example.com")
+	body := testBody(t)
+
+	s, err := NewSMIME(certFile, keyFile, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := s.Secure(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSMIMESignature(t, signed, body, cert)
+
+	s, err = NewSMIME(certFile, keyFile, []string{rcptFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	enveloped, err := s.Secure(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct := enveloped.Header.Get("Content-Type"); ct != `application/pkcs7-mime; smime-type=enveloped-data; name="smime.p7m"` {
+		t.Errorf("Content-Type %q", ct)
+	}
+	p7, err := pkcs7.Parse(decodeBase64(t, enveloped))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p7.Decrypt(cert, rcptKey); err == nil {
+		t.Error("decrypted with key of other certificate")
+	}
+	content, err := p7.Decrypt(rcptCert, rcptKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSMIMESignature(t, parsePart(t, content), body, cert)
+}
+
+func armored(t *testing.T, blockType string, serialize func(w *bytes.Buffer) error) []byte {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, blockType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data bytes.Buffer
+	if err := serialize(&data); err != nil {
+		t.Fatal(err)
+	}
+	w.Write(data.Bytes())
+	w.Close()
+	return buf.Bytes()
+}
+
+/* new entity with secret key file protected by |passphrase| if set */
+func pgpKey(t *testing.T, dir string, name string, passphrase string) (*openpgp.Entity, string) {
+	e, err := openpgp.NewEntity(name, "", name, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if passphrase != "" {
+		if err := e.EncryptPrivateKeys([]byte(passphrase), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	key := armored(t, openpgp.PrivateKeyType, func(w *bytes.Buffer) error { return e.SerializePrivateWithoutSigning(w, nil) })
+	if passphrase != "" {
+		if err := e.DecryptPrivateKeys([]byte(passphrase)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return e, writeFile(t, dir, name+".asc", key)
+}
+
+func TestPGP(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	signer, keyFile := pgpKey(t, dir, "ct@example.com", "s3cret")
+	rcpt, _ := pgpKey(t, dir, "soc@example.com", "")
+	rcptFile := writeFile(t, dir, "recipients.asc",
+		armored(t, openpgp.PublicKeyType, func(w *bytes.Buffer) error { return rcpt.Serialize(w) }))
+	body := testBody(t)
+
+	if _, err := NewPGP(keyFile, "wrong", ""); err == nil {
+		t.Error("secret key decrypted with wrong passphrase")
+	}
+	if _, err := NewPGP(rcptFile, "", ""); err == nil {
+		t.Error("no error for public keyring as signing key")
+	}
+
+	s, err := NewPGP(keyFile, "s3cret", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := s.Secure(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := splitMultipart(t, signed, "signed")
+	if len(parts) != 2 || !bytes.Equal(parts[0], body.Bytes()) {
+		t.Fatalf("signed entity differs from body\n%s", signed.Body)
+	}
+	sig := parsePart(t, parts[1])
+	if ct := sig.Header.Get("Content-Type"); ct != `application/pgp-signature; name="signature.asc"` {
+		t.Errorf("signature Content-Type %q", ct)
+	}
+	if _, err := openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{signer}, bytes.NewReader(parts[0]),
+		bytes.NewReader(sig.Body), nil); err != nil {
+		t.Errorf("invalid signature (%v)", err)
+	}
+
+	s, err = NewPGP(keyFile, "s3cret", rcptFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := s.Secure(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts = splitMultipart(t, encrypted, "encrypted")
+	if len(parts) != 2 {
+		t.Fatalf("%d parts in multipart/encrypted", len(parts))
+	}
+	if control := parsePart(t, parts[0]); string(control.Body) != "Version: 1\r\n" {
+		t.Errorf("control part %q", control.Body)
+	}
+	block, err := armor.Decode(bytes.NewReader(parsePart(t, parts[1]).Body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, err := openpgp.ReadMessage(block.Body, openpgp.EntityList{rcpt, signer}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := ioutil.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(content, body.Bytes()) {
+		t.Errorf("decrypted content differs from body\n%s", content)
+	}
+	if !md.IsSigned || md.SignedBy == nil || md.SignatureError != nil {
+		t.Errorf("encrypted message is not signed (%v)", md.SignatureError)
+	}
+}
+
+func TestSecurerConfig(t *testing.T) {
+	_, err := New([]byte(`{"notify_persons": ["soc@example.com"], "smtp_host": "localhost", "smtp_from": "ct@example.com",
+		"smime_cert": "cert.pem", "smime_key": "key.pem", "pgp_key": "key.asc"}`))
+	if err == nil {
+		t.Error("no error with both S/MIME and OpenPGP set")
+	}
+}
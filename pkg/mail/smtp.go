@@ -0,0 +1,176 @@
+package mail
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+const (
+	/* STARTTLS if server offers it, like smtp.SendMail */
+	TLSAuto     = "auto"
+	TLSNone     = "none"
+	TLSStartTLS = "starttls"
+	/* SMTPS, usually port 465 */
+	TLSImplicit = "tls"
+)
+
+type loginAuth struct {
+	user, password string
+}
+
+// LoginAuth returns AUTH LOGIN mechanism, refuses to send password without TLS
+// to hosts other than localhost like smtp.PlainAuth.
+func LoginAuth(user string, password string) smtp.Auth {
+	return &loginAuth{user, password}
+}
+
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	if !server.TLS && server.Name != "localhost" && server.Name != "127.0.0.1" && server.Name != "::1" {
+		return "", nil, errors.New("unencrypted connection")
+	}
+	return "LOGIN", nil, nil
+}
+
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	switch string(fromServer) {
+	case "Username:", "User Name\x00":
+		return []byte(a.user), nil
+	case "Password:", "Password\x00":
+		return []byte(a.password), nil
+	}
+	return nil, fmt.Errorf("unexpected LOGIN challenge %q", fromServer)
+}
+
+func (s *CertHandler) initSMTP() error {
+	switch s.TLSMode {
+	case "":
+		s.TLSMode = TLSAuto
+	case TLSAuto, TLSNone, TLSStartTLS, TLSImplicit:
+	default:
+		return fmt.Errorf("unknown smtp_tls %q", s.TLSMode)
+	}
+
+	serverName := s.ServerName
+	if serverName == "" {
+		serverName = s.Host
+	}
+	s.tlsConfig = &tls.Config{ServerName: serverName}
+	if s.CAFile != "" {
+		pem, err := ioutil.ReadFile(s.CAFile)
+		if err != nil {
+			return err
+		}
+		s.tlsConfig.RootCAs = x509.NewCertPool()
+		if !s.tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return errors.New("no certificates in " + s.CAFile)
+		}
+	}
+
+	switch s.AuthMech {
+	case "":
+		if s.User != "" && s.Password != "" {
+			s.auth = smtp.PlainAuth("", s.User, s.Password, serverName)
+		}
+	case "none":
+	case "plain":
+		s.auth = smtp.PlainAuth("", s.User, s.Password, serverName)
+	case "login":
+		s.auth = LoginAuth(s.User, s.Password)
+	case "cram-md5":
+		s.auth = smtp.CRAMMD5Auth(s.User, s.Password)
+	default:
+		return fmt.Errorf("unknown smtp_auth %q", s.AuthMech)
+	}
+	return nil
+}
+
+func (s *CertHandler) dial() (*smtp.Client, error) {
+	addr := net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+
+	var conn net.Conn
+	var err error
+	if s.TLSMode == TLSImplicit {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, s.tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	/* whole session including DATA should not hang forever */
+	conn.SetDeadline(time.Now().Add(5 * time.Minute))
+
+	c, err := smtp.NewClient(conn, s.tlsConfig.ServerName)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if s.TLSMode == TLSAuto || s.TLSMode == TLSStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			err = c.StartTLS(s.tlsConfig)
+		} else if s.TLSMode == TLSStartTLS {
+			err = errors.New("server does not support STARTTLS")
+		}
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+/* bare address for MAIL FROM and RCPT TO, "Name <addr>" is only valid in headers */
+func envelope(addr string) string {
+	if a, err := mail.ParseAddress(addr); err == nil {
+		return a.Address
+	}
+	return addr
+}
+
+func (s *CertHandler) send(msg []byte) error {
+	c, err := s.dial()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if s.auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("server does not support AUTH")
+		}
+		if err := c.Auth(s.auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(envelope(s.From)); err != nil {
+		return err
+	}
+	for _, rcpt := range s.Emails {
+		if err := c.Rcpt(envelope(rcpt)); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
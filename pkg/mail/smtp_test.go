@@ -0,0 +1,298 @@
+package mail
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+/* session recorded by fakeSMTP */
+type session struct {
+	tls      bool
+	authTLS  bool
+	user     string
+	password string
+	from     string
+	rcpt     []string
+	data     []byte
+}
+
+// fakeSMTP is SMTP server on net.Listener good enough for net/smtp client.
+type fakeSMTP struct {
+	ln       net.Listener
+	tls      *tls.Config
+	starttls bool
+	auth     bool
+
+	mu       sync.Mutex
+	sessions []*session
+	done     sync.WaitGroup
+}
+
+/* self-signed certificate for localhost, its PEM is written to |dir| */
+func testCert(t *testing.T, dir string) (tls.Certificate, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "ca.pem")
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, path
+}
+
+/* |starttls| and |auth| are offered in EHLO, |implicit| listens for TLS */
+func newFakeSMTP(t *testing.T, cert tls.Certificate, implicit bool, starttls bool, auth bool) *fakeSMTP {
+	f := &fakeSMTP{tls: &tls.Config{Certificates: []tls.Certificate{cert}}, starttls: starttls, auth: auth}
+	var err error
+	if implicit {
+		f.ln, err = tls.Listen("tcp", "127.0.0.1:0", f.tls)
+	} else {
+		f.ln, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	go f.serve()
+	return f
+}
+
+func (f *fakeSMTP) port() int {
+	return f.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (f *fakeSMTP) serve() {
+	for {
+		conn, err := f.ln.Accept()
+		if err != nil {
+			return
+		}
+		f.done.Add(1)
+		go func() {
+			defer f.done.Done()
+			defer conn.Close()
+			_, implicit := conn.(*tls.Conn)
+			f.session(conn, &session{tls: implicit})
+		}()
+	}
+}
+
+/* sessions after all connections are closed */
+func (f *fakeSMTP) close() []*session {
+	f.ln.Close()
+	f.done.Wait()
+	return f.sessions
+}
+
+func (f *fakeSMTP) session(conn net.Conn, s *session) {
+	f.mu.Lock()
+	f.sessions = append(f.sessions, s)
+	f.mu.Unlock()
+
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost ESMTP fake")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd, arg := line, ""
+		if i := strings.IndexByte(line, ' '); i >= 0 {
+			cmd, arg = line[:i], line[i+1:]
+		}
+		switch strings.ToUpper(cmd) {
+		case "EHLO":
+			ext := []string{"localhost"}
+			if f.starttls && !s.tls {
+				ext = append(ext, "STARTTLS")
+			}
+			if f.auth {
+				ext = append(ext, "AUTH PLAIN LOGIN")
+			}
+			for i, e := range ext {
+				sep := "-"
+				if i == len(ext)-1 {
+					sep = " "
+				}
+				tp.PrintfLine("250%s%s", sep, e)
+			}
+		case "STARTTLS":
+			tp.PrintfLine("220 Ready to start TLS")
+			tlsConn := tls.Server(conn, f.tls)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			s.tls = true
+			tp = textproto.NewConn(tlsConn)
+		case "AUTH":
+			if !f.auth {
+				tp.PrintfLine("502 Not implemented")
+				continue
+			}
+			s.authTLS = s.tls
+			args := strings.Fields(arg)
+			switch {
+			case len(args) == 2 && args[0] == "PLAIN":
+				cred, _ := base64.StdEncoding.DecodeString(args[1])
+				parts := strings.Split(string(cred), "\x00")
+				if len(parts) == 3 {
+					s.user, s.password = parts[1], parts[2]
+				}
+			case len(args) == 1 && args[0] == "LOGIN":
+				s.user = f.challenge(tp, "Username:")
+				s.password = f.challenge(tp, "Password:")
+			default:
+				tp.PrintfLine("504 Unrecognized authentication type")
+				continue
+			}
+			tp.PrintfLine("235 Authentication successful")
+		case "MAIL":
+			s.from = strings.TrimPrefix(arg, "FROM:")
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			s.rcpt = append(s.rcpt, strings.TrimPrefix(arg, "TO:"))
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			s.data, _ = tp.ReadDotBytes()
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 Bye")
+			return
+		default:
+			tp.PrintfLine("250 OK")
+		}
+	}
+}
+
+func (f *fakeSMTP) challenge(tp *textproto.Conn, prompt string) string {
+	tp.PrintfLine("334 %s", base64.StdEncoding.EncodeToString([]byte(prompt)))
+	line, _ := tp.ReadLine()
+	answer, _ := base64.StdEncoding.DecodeString(line)
+	return string(answer)
+}
+
+func TestSend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ct_mon_smtp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cert, caFile := testCert(t, dir)
+
+	tests := []struct {
+		name     string
+		conf     map[string]interface{}
+		starttls bool
+		auth     bool
+		implicit bool
+		tls      bool
+		user     string
+		fail     bool
+	}{
+		{name: "starttls plain", conf: map[string]interface{}{"smtp_user": "ct", "smtp_password": "pw"},
+			starttls: true, auth: true, tls: true, user: "ct"},
+		{name: "starttls login", conf: map[string]interface{}{"smtp_user": "ct", "smtp_password": "pw", "smtp_auth": "login", "smtp_tls": "starttls"},
+			starttls: true, auth: true, tls: true, user: "ct"},
+		{name: "implicit tls", conf: map[string]interface{}{"smtp_user": "ct", "smtp_password": "pw", "smtp_tls": "tls"},
+			auth: true, implicit: true, tls: true, user: "ct"},
+		{name: "auto without starttls", conf: map[string]interface{}{}},
+		{name: "tls none", conf: map[string]interface{}{"smtp_tls": "none"}, starttls: true},
+		{name: "starttls required", conf: map[string]interface{}{"smtp_tls": "starttls"}, fail: true},
+		{name: "auth not offered", conf: map[string]interface{}{"smtp_user": "ct", "smtp_password": "pw"},
+			starttls: true, tls: true, fail: true},
+		{name: "untrusted certificate", conf: map[string]interface{}{"smtp_ca_file": ""}, starttls: true, fail: true},
+	}
+	for _, tt := range tests {
+		f := newFakeSMTP(t, cert, tt.implicit, tt.starttls, tt.auth)
+		conf := map[string]interface{}{"smtp_host": "127.0.0.1", "smtp_port": f.port(),
+			"smtp_server_name": "localhost", "smtp_ca_file": caFile}
+		for k, v := range tt.conf {
+			conf[k] = v
+		}
+		s := testHandler(t, conf)
+		err := s.Notify(testMatch)
+		sessions := f.close()
+		if (err != nil) != tt.fail {
+			t.Errorf("%s: error %v", tt.name, err)
+		}
+		if tt.fail {
+			for _, sess := range sessions {
+				if sess.data != nil || sess.password != "" {
+					t.Errorf("%s: failed session sent %q password %q", tt.name, sess.data, sess.password)
+				}
+			}
+			continue
+		}
+		if len(sessions) != 1 {
+			t.Errorf("%s: %d sessions", tt.name, len(sessions))
+			continue
+		}
+		sess := sessions[0]
+		if sess.tls != tt.tls {
+			t.Errorf("%s: tls %v, want %v", tt.name, sess.tls, tt.tls)
+		}
+		if sess.user != tt.user || (tt.user != "" && (sess.password != "pw" || !sess.authTLS)) {
+			t.Errorf("%s: auth %q/%q over tls %v", tt.name, sess.user, sess.password, sess.authTLS)
+		}
+		if sess.from != "<ct@example.com>" || strings.Join(sess.rcpt, ",") != "<soc@example.com>,<team@example.com>" {
+			t.Errorf("%s: envelope %s -> %v", tt.name, sess.from, sess.rcpt)
+		}
+		checkMessage(t, tt.name, sess.data)
+	}
+}
+
+func checkMessage(t *testing.T, name string, data []byte) {
+	m, err := mail.ReadMessage(bufio.NewReader(bytes.NewReader(data)))
+	if err != nil {
+		t.Errorf("%s: invalid message (%v)\n%s", name, err, data)
+		return
+	}
+	if from := m.Header.Get("From"); from != "<ct@example.com>" {
+		t.Errorf("%s: From %q", name, from)
+	}
+	if subj := m.Header.Get("Subject"); subj != defaultSubject {
+		t.Errorf("%s: Subject %q", name, subj)
+	}
+	if !strings.HasPrefix(m.Header.Get("Content-Type"), "multipart/alternative;") {
+		t.Errorf("%s: Content-Type %q", name, m.Header.Get("Content-Type"))
+	}
+	body, _ := ioutil.ReadAll(m.Body)
+	if !bytes.Contains(body, []byte("CN: login.example.com\n")) {
+		t.Errorf("%s: body has no CN\n%s", name, body)
+	}
+}
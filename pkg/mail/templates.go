@@ -0,0 +1,209 @@
+package mail
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"io"
+	"io/ioutil"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+const defaultSubject = "Certificate Transparency monitor notification"
+
+const defaultDigestSubject = "{{ .Count }} new Certificate Transparency matches"
+
+const defaultText = `{{ .Title }}
+
+CN: {{ .CN }}
+Issuer: {{ .Issuer }}
+Valid: {{ date .NotBefore }} - {{ date .NotAfter }}
+Rules: {{ join .Rules ", " }} ({{ .Severity }})
+Log: {{ .Log }}
+Log Index: {{ .Index }}
+SHA256: {{ .SHA256 }}
+Details: {{ .Link }}
+
+SANs:
+{{ range .DNSNames }}    {{ . }}
+{{ end }}
+{{ .PEM }}
+`
+
+const defaultHTML = `<!DOCTYPE html>
+<html>
+    <head>
+        <meta charset="UTF-8">
+        <title>Certificate Transparency notification</title>
+    </head>
+    <body>
+        <h2>{{ .Title }}</h2>
+        <table>
+            <tr><th align="left">CN:</th><td>{{ .CN }}</td></tr>
+            <tr><th align="left">Issuer:</th><td>{{ .Issuer }}</td></tr>
+            <tr><th align="left">Valid:</th><td>{{ date .NotBefore }} - {{ date .NotAfter }}</td></tr>
+            <tr><th align="left">Rules:</th><td>{{ join .Rules ", " }} ({{ .Severity }})</td></tr>
+            <tr><th align="left">Log:</th><td>{{ .Log }}</td></tr>
+            <tr><th align="left">Log Index:</th><td>{{ .Index }}</td></tr>
+            <tr><th align="left">SHA256:</th><td>{{ .SHA256 }}</td></tr>
+            <tr><th align="left"><a href="{{ .Link }}">View details</a></th><td></td></tr>
+            <tr><th align="left">SANs:</th><td></td></tr>
+            <tr><td colspan="2" align="left"><ol>{{ range .DNSNames }}<li>{{ . }}</li>{{ end }}</ol></td></tr>
+            <tr><td colspan="2"><pre>{{ .PEM }}</pre></td></tr>
+        </table>
+    </body>
+</html>
+`
+
+const defaultDigestText = `{{ .Count }} new matches
+{{ range .Matches }}
+{{ .Title }}: {{ .CN }}
+Issuer: {{ .Issuer }}
+Valid: {{ date .NotBefore }} - {{ date .NotAfter }}
+Rules: {{ join .Rules ", " }} ({{ .Severity }})
+Log: {{ .Log }} #{{ .Index }}
+Details: {{ .Link }}
+SANs: {{ join .DNSNames ", " }}
+{{ end }}`
+
+const defaultDigestHTML = `<!DOCTYPE html>
+<html>
+    <head>
+        <meta charset="UTF-8">
+        <title>Certificate Transparency notification</title>
+    </head>
+    <body>
+        <h2>{{ .Count }} new matches</h2>
+        <table border="1" cellpadding="4" cellspacing="0">
+            <tr><th>CN</th><th>Type</th><th>Issuer</th><th>Valid</th><th>Rules</th><th>SANs</th></tr>
+            {{- range .Matches }}
+            <tr>
+                <td><a href="{{ .Link }}">{{ .CN }}</a></td>
+                <td>{{ if .Precert }}precertificate{{ else }}certificate{{ end }}</td>
+                <td>{{ .Issuer }}</td>
+                <td>{{ date .NotBefore }} - {{ date .NotAfter }}</td>
+                <td>{{ join .Rules ", " }} ({{ .Severity }})</td>
+                <td>{{ join .DNSNames ", " }}</td>
+            </tr>
+            {{- end }}
+        </table>
+    </body>
+</html>
+`
+
+// MatchData is passed to subject and body templates of single match email.
+type MatchData struct {
+	*models.Match
+	Title string
+	CN    string
+	Link  string
+}
+
+// DigestData is passed to subject and body templates of digest email.
+type DigestData struct {
+	Count   int
+	Matches []*MatchData
+}
+
+func newMatchData(m *models.Match) *MatchData {
+	title := "New certificate found"
+	if m.Escalated {
+		title = "Escalated certificate"
+	} else if m.Precert {
+		title = "New precertificate found"
+	} else if m.FinalForPrecert {
+		title = "Final certificate for previously reported precert"
+	}
+	return &MatchData{Match: m, Title: title, CN: m.CommonName, Link: "https://crt.sh/?sha256=" + m.SHA256}
+}
+
+var funcs = map[string]interface{}{
+	"join": strings.Join,
+	"date": func(t time.Time) string { return t.Format("2006-01-02") },
+}
+
+type templates struct {
+	subject       *template.Template
+	ruleSubjects  map[string]*template.Template
+	text          *template.Template
+	html          *htmltemplate.Template
+	digestSubject *template.Template
+	digestText    *template.Template
+	digestHTML    *htmltemplate.Template
+}
+
+/* file content if |path| is set, |def| otherwise */
+func source(path string, def string) (string, error) {
+	if path == "" {
+		return def, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	return string(data), err
+}
+
+func parseText(name string, path string, def string) (*template.Template, error) {
+	src, err := source(path, def)
+	if err != nil {
+		return nil, err
+	}
+	return template.New(name).Funcs(funcs).Parse(src)
+}
+
+func parseHTML(name string, path string, def string) (*htmltemplate.Template, error) {
+	src, err := source(path, def)
+	if err != nil {
+		return nil, err
+	}
+	return htmltemplate.New(name).Funcs(funcs).Parse(src)
+}
+
+func (s *CertHandler) loadTemplates() error {
+	var err error
+	t := &templates{ruleSubjects: make(map[string]*template.Template)}
+	if t.subject, err = template.New("subject").Funcs(funcs).Parse(s.Subj); err != nil {
+		return err
+	}
+	for rule, subj := range s.RuleSubjects {
+		if t.ruleSubjects[rule], err = template.New("subject_" + rule).Funcs(funcs).Parse(subj); err != nil {
+			return err
+		}
+	}
+	if t.text, err = parseText("text", s.TextTemplate, defaultText); err != nil {
+		return err
+	}
+	if t.html, err = parseHTML("html", s.HTMLTemplate, defaultHTML); err != nil {
+		return err
+	}
+	if t.digestSubject, err = template.New("digest_subject").Funcs(funcs).Parse(s.DigestSubj); err != nil {
+		return err
+	}
+	if t.digestText, err = parseText("digest_text", s.DigestTextTemplate, defaultDigestText); err != nil {
+		return err
+	}
+	if t.digestHTML, err = parseHTML("digest_html", s.DigestHTMLTemplate, defaultDigestHTML); err != nil {
+		return err
+	}
+	s.tpl = t
+	return nil
+}
+
+/* subject of the first hit rule having one */
+func (t *templates) subjectFor(m *models.Match) *template.Template {
+	for _, rule := range m.Rules {
+		if subj, ok := t.ruleSubjects[rule]; ok {
+			return subj
+		}
+	}
+	return t.subject
+}
+
+func execute(t interface {
+	Execute(w io.Writer, data interface{}) error
+}, data interface{}) (string, error) {
+	var buf bytes.Buffer
+	err := t.Execute(&buf, data)
+	return buf.String(), err
+}
@@ -0,0 +1,175 @@
+package mail
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"mime"
+	"net/mail"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+var update = flag.Bool("update", false, "update .golden files in testdata")
+
+var testMatch = &models.Match{
+	Timestamp:   time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC),
+	CommonName:  "login.example.com",
+	DNSNames:    []string{"login.example.com", "www.example.com"},
+	Issuer:      "Test CA",
+	NotBefore:   time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC),
+	NotAfter:    time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC),
+	Rules:       []string{"phishing"},
+	Severity:    models.SeverityHigh,
+	Log:         "https://ct.example.com/",
+	Index:       42,
+	Fingerprint: "ab12",
+	SHA256:      "0123456789abcdef",
+	PEM:         "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+}
+
+/* special chars in CN for header encoding and HTML escaping */
+var testPrecert = &models.Match{
+	CommonName: "<b>пример</b>.example.com",
+	DNSNames:   []string{"<b>пример</b>.example.com"},
+	Issuer:     "Test CA",
+	NotBefore:  time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC),
+	NotAfter:   time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC),
+	Rules:      []string{"idn", "phishing"},
+	Severity:   models.SeverityCritical,
+	Precert:    true,
+	Log:        "https://ct.example.com/",
+	Index:      43,
+	SHA256:     "fedcba9876543210",
+}
+
+func testHandler(t *testing.T, conf map[string]interface{}) *CertHandler {
+	c := map[string]interface{}{"notify_persons": []string{"soc@example.com", "Team <team@example.com>"},
+		"smtp_host": "localhost", "smtp_from": "ct@example.com"}
+	for k, v := range conf {
+		c[k] = v
+	}
+	data, _ := json.Marshal(c)
+	s, err := New(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+var (
+	dateHeader      = regexp.MustCompile(`(?m)^Date: .*\r$`)
+	messageIDHeader = regexp.MustCompile(`(?m)^Message-ID: .*\r$`)
+)
+
+/* replaces random boundary, date and id of |msg| */
+func normalize(t *testing.T, msg []byte) []byte {
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	if err != nil {
+		t.Fatalf("invalid message (%v)\n%s", err, msg)
+	}
+	_, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	if err != nil || params["boundary"] == "" {
+		t.Fatalf("invalid Content-Type %q (%v)", m.Header.Get("Content-Type"), err)
+	}
+	msg = bytes.Replace(msg, []byte(params["boundary"]), []byte("BOUNDARY"), -1)
+	msg = dateHeader.ReplaceAll(msg, []byte("Date: DATE\r"))
+	return messageIDHeader.ReplaceAll(msg, []byte("Message-ID: <ID@example.com>\r"))
+}
+
+/* compares |msg| to testdata/|name|.golden, rewrites the file with -update */
+func golden(t *testing.T, name string, msg []byte) {
+	got := normalize(t, msg)
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := ioutil.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs from %s, run go test -update if the change is expected\n%s", name, path, got)
+	}
+}
+
+func TestGolden(t *testing.T) {
+	s := testHandler(t, map[string]interface{}{
+		"smtp_subject":  "[ct_mon] {{ .Title }}: {{ .CN }}",
+		"rule_subjects": map[string]string{"idn": "[ct_mon] IDN {{ .CN }} ({{ .Severity }})"},
+	})
+	custom := testHandler(t, map[string]interface{}{
+		"text_template": "testdata/custom.txt.tpl",
+		"html_template": "testdata/custom.html.tpl",
+	})
+
+	messages := []struct {
+		name   string
+		render func() ([]byte, error)
+	}{
+		{"match", func() ([]byte, error) { return s.Message(testMatch) }},
+		{"rule_subject", func() ([]byte, error) { return s.Message(testPrecert) }},
+		{"custom", func() ([]byte, error) { return custom.Message(testMatch) }},
+		{"digest", func() ([]byte, error) { return s.DigestMessage([]*models.Match{testMatch, testPrecert}) }},
+		{"health", func() ([]byte, error) {
+			return s.HealthMessage(&models.LogHealth{Log: "https://ct.example.com/", Problem: "stalled",
+				Since: time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC), Message: "no new entries for 1h0m0s"})
+		}},
+		{"triage", func() ([]byte, error) {
+			return s.TriageMessage(&models.TriageEvent{Match: testPrecert, Status: models.TriageMalicious,
+				By: "alice", Assignee: "bob"})
+		}},
+	}
+	for _, m := range messages {
+		msg, err := m.render()
+		if err != nil {
+			t.Errorf("%s: %v", m.name, err)
+			continue
+		}
+		golden(t, m.name, msg)
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	s := testHandler(t, map[string]interface{}{"smtp_subject": "{{ .CN }}\r\nBcc: evil@example.com"})
+	msg, err := s.Message(testPrecert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bcc := m.Header.Get("Bcc"); bcc != "" {
+		t.Errorf("header injected through subject: Bcc %q", bcc)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if err != nil || subject != "<b>пример</b>.example.com  Bcc: evil@example.com" {
+		t.Errorf("subject %q (%v)", subject, err)
+	}
+	if to := m.Header.Get("To"); !strings.Contains(to, `"Team" <team@example.com>`) {
+		t.Errorf("To %q", to)
+	}
+}
+
+func TestBadTemplate(t *testing.T) {
+	for _, conf := range []string{
+		`"smtp_subject": "{{ .CN"`,
+		`"rule_subjects": {"idn": "{{ end }}"}`,
+		`"text_template": "testdata/missing.tpl"`,
+	} {
+		_, err := New([]byte(`{"notify_persons": ["soc@example.com"], "smtp_host": "localhost", "smtp_from": "ct@example.com", ` + conf + `}`))
+		if err == nil {
+			t.Errorf("no error for %s", conf)
+		}
+	}
+}
@@ -0,0 +1 @@
+<p>{{ .Title }} for <a href="{{ .Link }}">{{ .CN }}</a></p>
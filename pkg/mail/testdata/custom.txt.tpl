@@ -0,0 +1,2 @@
+{{ .Title }} for {{ .CN }}
+Rules: {{ join .Rules ", " }}
@@ -0,0 +1,29 @@
+package mail
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+// TriageMessage renders email about triage status change |t|.
+func (s *CertHandler) TriageMessage(t *models.TriageEvent) ([]byte, error) {
+	title := t.Title()
+	m := t.Match
+	text := fmt.Sprintf("%s\n\nCN: %s\nStatus: %s\nAssignee: %s\nNote: %s\nSHA256: %s\nDetails: https://crt.sh/?sha256=%s\n",
+		title, m.CommonName, t.Status, t.Assignee, t.Note(), m.SHA256, m.SHA256)
+	body := fmt.Sprintf("<!DOCTYPE html>\n<html>\n<body>\n<h3>%s</h3>\n<p>CN: %s<br>Status: %s<br>Assignee: %s<br>Note: %s<br>SHA256: %s</p>\n<p><a href=\"https://crt.sh/?sha256=%s\">View details</a></p>\n</body>\n</html>\n",
+		html.EscapeString(title), html.EscapeString(m.CommonName), html.EscapeString(string(t.Status)),
+		html.EscapeString(t.Assignee), html.EscapeString(t.Note()), m.SHA256, m.SHA256)
+	return s.compose(title, text, body)
+}
+
+/* triage changes are never collected into digests */
+func (s *CertHandler) NotifyTriage(t *models.TriageEvent) error {
+	msg, err := s.TriageMessage(t)
+	if err != nil {
+		return err
+	}
+	return s.send(msg)
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/google/certificate-transparency/go/x509"
 	"github.com/renstrom/fuzzysearch/fuzzy"
 	"github.com/kyprizel/certificate-transparency/go/scanner"
+
+	"github.com/kyprizel/ct_mon/models"
 )
 
 type MatchSubjectRegexUnkCA struct {
@@ -60,3 +62,48 @@ func CreateMatcherFromFlags(MatchSubjectRegex string, CNset map[string]bool, Fuz
 		FuzzySubject:               FuzzySubjects,
 		CAWhitelist:                CNset}, nil
 }
+
+type Rule struct {
+	Name     string
+	Severity models.Severity
+	Matcher  MatchSubjectRegexUnkCA
+}
+
+// RuleMatcher matches entries matching any of |Rules|.
+type RuleMatcher struct {
+	Rules []Rule
+}
+
+func (m RuleMatcher) CertificateMatches(c *x509.Certificate) bool {
+	for _, r := range m.Rules {
+		if r.Matcher.CertificateMatches(c) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m RuleMatcher) PrecertificateMatches(p *ct.Precertificate) bool {
+	for _, r := range m.Rules {
+		if r.Matcher.PrecertificateMatches(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Hits returns rules matching |c| and their highest severity.
+func (m RuleMatcher) Hits(c *x509.Certificate) ([]string, models.Severity) {
+	var names []string
+	var severity models.Severity
+	for _, r := range m.Rules {
+		if !r.Matcher.CertificateMatches(c) {
+			continue
+		}
+		names = append(names, r.Name)
+		if r.Severity.Level() > severity.Level() {
+			severity = r.Severity
+		}
+	}
+	return names, severity
+}
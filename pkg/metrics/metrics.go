@@ -0,0 +1,160 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type value struct {
+	labels []string
+	v      float64
+	f      func() float64
+}
+
+// Family is a counter or gauge with labels, exported in Prometheus text format.
+type Family struct {
+	Name   string
+	Help   string
+	Type   string
+	Labels []string
+
+	mu     sync.Mutex
+	values map[string]*value
+}
+
+var (
+	mu       sync.Mutex
+	families = make(map[string]*Family)
+)
+
+func register(name, help, typ string, labels []string) *Family {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := families[name]; ok {
+		panic("metrics: " + name + " registered twice")
+	}
+	f := &Family{Name: name, Help: help, Type: typ, Labels: labels, values: make(map[string]*value)}
+	families[name] = f
+	return f
+}
+
+// NewCounter registers counter |name| with |labels|.
+func NewCounter(name, help string, labels ...string) *Family {
+	return register(name, help, "counter", labels)
+}
+
+// NewGauge registers gauge |name| with |labels|.
+func NewGauge(name, help string, labels ...string) *Family {
+	return register(name, help, "gauge", labels)
+}
+
+func (f *Family) get(labels []string) *value {
+	if len(labels) != len(f.Labels) {
+		panic(fmt.Sprintf("metrics: %s takes %d labels, got %d", f.Name, len(f.Labels), len(labels)))
+	}
+	key := strings.Join(labels, "\xff")
+	v, ok := f.values[key]
+	if !ok {
+		v = &value{labels: append([]string(nil), labels...)}
+		f.values[key] = v
+	}
+	return v
+}
+
+// Add adds |d| to value with |labels|.
+func (f *Family) Add(d float64, labels ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.get(labels).v += d
+}
+
+func (f *Family) Inc(labels ...string) {
+	f.Add(1, labels...)
+}
+
+// Set sets value with |labels| to |v|.
+func (f *Family) Set(v float64, labels ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.get(labels).v = v
+}
+
+// Func makes value with |labels| computed by |fn| on every scrape.
+func (f *Family) Func(fn func() float64, labels ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.get(labels).f = fn
+}
+
+// Value returns current value with |labels|.
+func (f *Family) Value(labels ...string) float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	v := f.get(labels)
+	if v.f != nil {
+		return v.f()
+	}
+	return v.v
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func (f *Family) write(w *bufio.Writer) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fmt.Fprintf(w, "# HELP %s %s\n", f.Name, strings.Replace(f.Help, "\n", " ", -1))
+	fmt.Fprintf(w, "# TYPE %s %s\n", f.Name, f.Type)
+
+	keys := make([]string, 0, len(f.values))
+	for k := range f.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := f.values[k]
+		w.WriteString(f.Name)
+		if len(f.Labels) > 0 {
+			w.WriteByte('{')
+			for i, l := range f.Labels {
+				if i > 0 {
+					w.WriteByte(',')
+				}
+				fmt.Fprintf(w, `%s="%s"`, l, escaper.Replace(v.labels[i]))
+			}
+			w.WriteByte('}')
+		}
+		x := v.v
+		if v.f != nil {
+			x = v.f()
+		}
+		fmt.Fprintf(w, " %s\n", strconv.FormatFloat(x, 'g', -1, 64))
+	}
+}
+
+// Handler serves all registered metrics in Prometheus text format.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		names := make([]string, 0, len(families))
+		for name := range families {
+			names = append(names, name)
+		}
+		mu.Unlock()
+		sort.Strings(names)
+
+		rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		w := bufio.NewWriter(rw)
+		for _, name := range names {
+			mu.Lock()
+			f := families[name]
+			mu.Unlock()
+			f.write(w)
+		}
+		w.Flush()
+	})
+}
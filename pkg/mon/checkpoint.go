@@ -0,0 +1,109 @@
+package mon
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"github.com/kyprizel/certificate-transparency/go/scanner"
+)
+
+/* entries [from, to) are handled */
+type span struct {
+	from, to int64
+}
+
+// handled marks entry |index| of the current pass as done, the checkpoint passes it
+// once all entries before it are done too.
+func (l *LogState) handled(index int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if index < l.StartIndex {
+		return
+	}
+	/* scanner fetches and matches entries out of order, so done entries are kept as merged spans */
+	i := sort.Search(len(l.spans), func(i int) bool { return l.spans[i].to >= index })
+	switch {
+	case i < len(l.spans) && l.spans[i].from <= index && index < l.spans[i].to:
+		return
+	case i < len(l.spans) && l.spans[i].to == index:
+		l.spans[i].to++
+		if i+1 < len(l.spans) && l.spans[i+1].from == l.spans[i].to {
+			l.spans[i].to = l.spans[i+1].to
+			l.spans = append(l.spans[:i+1], l.spans[i+2:]...)
+		}
+	case i < len(l.spans) && l.spans[i].from == index+1:
+		l.spans[i].from--
+	default:
+		l.spans = append(l.spans, span{})
+		copy(l.spans[i+1:], l.spans[i:])
+		l.spans[i] = span{index, index + 1}
+	}
+}
+
+// hold keeps the checkpoint at or before |index|, its match wasn't handed to sinks and
+// should be handled again when the scan resumes.
+func (l *LogState) hold(index int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.held < 0 || index < l.held {
+		l.held = index
+	}
+}
+
+/* called with |mu| held, the lowest index not handled yet */
+func (l *LogState) next() int64 {
+	if len(l.spans) > 0 && l.spans[0].from == l.StartIndex {
+		return l.spans[0].to
+	}
+	return l.StartIndex
+}
+
+/* index the scan by |s| can resume from, ok is false if |s| is finished */
+func (l *LogState) checkpoint(s *scanner.Scanner) (index int64, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if s != l.scanner || l.stopped {
+		return 0, false
+	}
+	return l.next(), true
+}
+
+/* index the finished or stopped scan resumes from */
+func (l *LogState) resume() (int64, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.next(), true
+}
+
+/* ends the scan, entries found later are held and the checkpoint stays before them */
+func (l *LogState) stop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.stopped = true
+}
+
+/* starts scan pass by |s| from StartIndex */
+func (l *LogState) start(s *scanner.Scanner) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.scanner = s
+}
+
+// advance finishes scan pass by |s|, the next one resumes from its checkpoint. Entries the
+// scanner failed to parse are never handled, so the whole pass is passed unless a match is held.
+func (l *LogState) advance(s *scanner.Scanner) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.stopped {
+		return l.next()
+	}
+	next := l.StartIndex + atomic.LoadInt64(&s.CertsProcessed)
+	if l.held >= 0 && l.held < next {
+		next = l.held
+	}
+	l.StartIndex = next
+	l.scanner = nil
+	l.spans = nil
+	l.held = -1
+	return l.StartIndex
+}
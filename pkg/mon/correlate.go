@@ -0,0 +1,81 @@
+package mon
+
+import (
+	"log"
+	"sync"
+
+	"github.com/google/certificate-transparency/go"
+
+	"github.com/kyprizel/ct_mon/pkg/certs"
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+/* in-memory precert set bound, used only without DB */
+const maxMemPrecerts = 100000
+
+// Correlator links precertificates with their final certificates by TBSHash,
+// state is kept in DB if configured.
+type Correlator struct {
+	db       db.Store
+	mu       sync.Mutex
+	precerts map[string]bool
+}
+
+func NewCorrelator(mdb db.Store) *Correlator {
+	return &Correlator{db: mdb, precerts: make(map[string]bool)}
+}
+
+// Precert records precertificate |entry| and returns its TBSHash.
+func (c *Correlator) Precert(logUri string, entry *ct.LogEntry) string {
+	tbs := &entry.Precert.TBSCertificate
+	hash, err := certs.TBSHash(tbs)
+	if err != nil {
+		log.Printf("Can't hash precert TBS at %d (%v)", entry.Index, err)
+		return ""
+	}
+
+	if c.db != nil {
+		p := &db.PrecertInfo{TBSHash: hash, Log: logUri, Index: entry.Index,
+			CommonName: tbs.Subject.CommonName, Issuer: tbs.Issuer.CommonName,
+			DNSNames: tbs.DNSNames}
+		if err := c.db.StorePrecert(p); err != nil {
+			log.Printf("Can't store precert %d (%v)", entry.Index, err)
+		}
+		return hash
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.precerts) >= maxMemPrecerts {
+		log.Print("Too many precerts in memory, dropping correlation state")
+		c.precerts = make(map[string]bool)
+	}
+	c.precerts[hash] = true
+	return hash
+}
+
+// Cert returns TBSHash of certificate |entry| and true if it is the final certificate
+// for previously seen precertificate.
+func (c *Correlator) Cert(logUri string, entry *ct.LogEntry) (string, bool) {
+	hash, err := certs.TBSHash(entry.X509Cert)
+	if err != nil {
+		log.Printf("Can't hash cert TBS at %d (%v)", entry.Index, err)
+		return "", false
+	}
+
+	if c.db != nil {
+		linked, err := c.db.LinkPrecert(hash, logUri, entry.Index)
+		if err != nil {
+			log.Printf("Can't link cert %d to precert (%v)", entry.Index, err)
+		}
+		return hash, linked
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.precerts[hash] {
+		delete(c.precerts, hash)
+		return hash, true
+	}
+	return hash, false
+}
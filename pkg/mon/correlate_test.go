@@ -0,0 +1,28 @@
+package mon
+
+import (
+	"testing"
+
+	"github.com/kyprizel/ct_mon/pkg/cttest"
+)
+
+func TestCorrelator(t *testing.T) {
+	entries := cttest.Entries(t, precertEntries)
+	c := NewCorrelator(nil)
+
+	if hash, linked := c.Cert("log", entries[1]); hash == "" || linked {
+		t.Fatalf("final certificate before precert: %q linked %v", hash, linked)
+	}
+	pre := c.Precert("log", entries[0])
+	if pre == "" {
+		t.Fatal("precert TBS is not hashed")
+	}
+	hash, linked := c.Cert("log", entries[1])
+	if hash != pre || !linked {
+		t.Errorf("final certificate %q linked %v, precert %q", hash, linked, pre)
+	}
+	/* linked once, the same certificate from another log is not final again */
+	if _, linked := c.Cert("other", entries[1]); linked {
+		t.Error("final certificate linked twice")
+	}
+}
@@ -0,0 +1,89 @@
+package mon
+
+import (
+	"log"
+	"sync"
+
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+/* in-memory fingerprint set bound, used only without DB */
+const maxMemFingerprints = 100000
+
+// Deduper tracks certificate sightings across logs so every certificate is handled once,
+// state is kept in DB if configured.
+type Deduper struct {
+	db   db.Store
+	mu   sync.Mutex
+	seen map[string]bool
+	/* certificates being handed to sinks, closed on release */
+	claims map[string]chan struct{}
+}
+
+func NewDeduper(mdb db.Store) *Deduper {
+	return &Deduper{db: mdb, seen: make(map[string]bool), claims: make(map[string]chan struct{})}
+}
+
+// Claim returns true if certificate |fingerprint| was not handled before, the caller handles it
+// and calls Release. Entries of the same certificate from other logs wait for the release.
+func (d *Deduper) Claim(fingerprint string) bool {
+	for {
+		d.mu.Lock()
+		wait, busy := d.claims[fingerprint]
+		if !busy {
+			d.claims[fingerprint] = make(chan struct{})
+		}
+		d.mu.Unlock()
+		if !busy {
+			break
+		}
+		<-wait
+	}
+	if d.Seen(fingerprint) {
+		d.Release(fingerprint)
+		return false
+	}
+	return true
+}
+
+// Release ends claim of certificate |fingerprint|, it's handled again unless its sighting was added.
+func (d *Deduper) Release(fingerprint string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	close(d.claims[fingerprint])
+	delete(d.claims, fingerprint)
+}
+
+// Seen returns true if certificate |fingerprint| was handled before.
+func (d *Deduper) Seen(fingerprint string) bool {
+	if d.db != nil {
+		_, err := d.db.FindSightings(fingerprint)
+		if err != nil && err != db.ErrNotFound {
+			/* better to notify twice than to lose a match */
+			log.Printf("Can't load sightings of %s (%v)", fingerprint, err)
+		}
+		return err == nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.seen[fingerprint]
+}
+
+// Add records sighting |s| of certificate |fingerprint| handed to sinks.
+func (d *Deduper) Add(fingerprint string, precert bool, s db.Sighting) {
+	if d.db != nil {
+		if _, err := d.db.AddSighting(fingerprint, precert, s); err != nil {
+			log.Printf("Can't store sighting of %s (%v)", fingerprint, err)
+		}
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.seen) >= maxMemFingerprints {
+		log.Print("Too many fingerprints in memory, dropping dedup state")
+		d.seen = make(map[string]bool)
+	}
+	d.seen[fingerprint] = true
+}
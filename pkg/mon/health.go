@@ -0,0 +1,69 @@
+package mon
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+const pingTimeout = 5 * time.Second
+
+/* /healthz fails only if the process should be restarted */
+func (m *MonCtx) healthz(w http.ResponseWriter, r *http.Request) {
+	var problems []string
+	stuck := time.Duration(m.conf.StuckTimeout) * time.Second
+	for _, h := range m.sinks {
+		if busy := h.Busy(); busy > stuck {
+			problems = append(problems, fmt.Sprintf("sink %s is handling a match for %s", h.Name, busy))
+		}
+	}
+	writeHealth(w, problems)
+}
+
+/* /readyz fails if matches may be late or lost */
+func (m *MonCtx) readyz(w http.ResponseWriter, r *http.Request) {
+	var problems []string
+	maxAge := time.Duration(m.conf.ReadySTHAge) * time.Minute
+	for _, l := range m.Logs {
+		age, ok := l.metrics.sthAge()
+		if !ok {
+			problems = append(problems, fmt.Sprintf("log %s STH is not fetched yet", l.Uri))
+		} else if age > maxAge {
+			problems = append(problems, fmt.Sprintf("log %s STH is not fetched for %s", l.Uri, age))
+		}
+		if lag := int64(l.metrics.lag()); m.conf.ReadyMaxLag > 0 && lag > m.conf.ReadyMaxLag {
+			problems = append(problems, fmt.Sprintf("log %s is %d entries behind", l.Uri, lag))
+		}
+	}
+	if m.db != nil {
+		if err := ping(m.db); err != nil {
+			problems = append(problems, fmt.Sprintf("DB is unreachable (%v)", err))
+		}
+	}
+	writeHealth(w, problems)
+}
+
+func ping(store db.Store) error {
+	res := make(chan error, 1)
+	go func() { res <- store.Ping() }()
+	select {
+	case err := <-res:
+		return err
+	case <-time.After(pingTimeout):
+		return fmt.Errorf("timeout exceeded")
+	}
+}
+
+func writeHealth(w http.ResponseWriter, problems []string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if len(problems) == 0 {
+		fmt.Fprintln(w, "ok")
+		return
+	}
+	w.WriteHeader(http.StatusServiceUnavailable)
+	for _, p := range problems {
+		fmt.Fprintln(w, p)
+	}
+}
@@ -0,0 +1,114 @@
+package mon
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/web"
+)
+
+const (
+	defaultMMD        = 24 * time.Hour
+	logHealthInterval = time.Minute
+)
+
+type logProblem struct {
+	since   time.Time
+	message string
+}
+
+// logProblems returns problems of |l| found at |now| by name.
+func (m *MonCtx) logProblems(l *LogState, now time.Time) map[string]logProblem {
+	problems := make(map[string]logProblem)
+	lm := l.metrics
+
+	if ts := loadTime(&lm.sthTime); !ts.IsZero() && now.Sub(ts) > l.MMD {
+		problems[models.LogSTHStale] = logProblem{ts.Add(l.MMD),
+			fmt.Sprintf("Latest STH was issued at %s, %s ago, MMD of the log is %s",
+				ts.UTC().Format(time.RFC3339), now.Sub(ts).Truncate(time.Second), l.MMD)}
+	}
+
+	stall := time.Duration(m.conf.LogStallPeriod) * time.Second
+	if grown := loadTime(&lm.grown); !grown.IsZero() && now.Sub(grown) > stall {
+		problems[models.LogNotGrowing] = logProblem{grown,
+			fmt.Sprintf("Tree size %d has not grown since %s", atomic.LoadInt64(&lm.treeSize), grown.UTC().Format(time.RFC3339))}
+	}
+
+	errPeriod := time.Duration(m.conf.LogErrorPeriod) * time.Second
+	if failing := loadTime(&lm.failing); !failing.IsZero() && now.Sub(failing) > errPeriod {
+		problems[models.LogFetchFailing] = logProblem{failing,
+			fmt.Sprintf("STH requests fail since %s", failing.UTC().Format(time.RFC3339))}
+	} else if stuck := lm.stuckSince(); !stuck.IsZero() && now.Sub(stuck) > errPeriod {
+		/* scanner retries failed get-entries forever, no progress is the only sign */
+		problems[models.LogFetchFailing] = logProblem{stuck,
+			fmt.Sprintf("Scan makes no progress since %s, %d entries behind the STH",
+				stuck.UTC().Format(time.RFC3339), int64(lm.lag()))}
+	}
+	return problems
+}
+
+// logStatus returns scan progress and problems of all logs for the dashboard.
+func (m *MonCtx) logStatus() []web.LogStatus {
+	now := time.Now()
+	var result []web.LogStatus
+	for _, l := range m.Logs {
+		lm := l.metrics
+		s := web.LogStatus{Uri: l.Uri, Index: atomic.LoadInt64(&lm.index),
+			TreeSize: atomic.LoadInt64(&lm.treeSize), Lag: int64(lm.lag())}
+		s.STHAge, s.Fetched = lm.sthAge()
+		for name, p := range m.logProblems(l, now) {
+			s.Problems = append(s.Problems, fmt.Sprintf("%s: %s", name, p.message))
+		}
+		sort.Strings(s.Problems)
+		result = append(result, s)
+	}
+	return result
+}
+
+// watchLogs sends log health changes to sinks every minute until |ctx| is done.
+func (m *MonCtx) watchLogs(ctx context.Context) {
+	alerts := make(map[*LogState]map[string]logProblem)
+	for _, l := range m.Logs {
+		alerts[l] = make(map[string]logProblem)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(logHealthInterval):
+		}
+
+		now := time.Now().UTC()
+		for _, l := range m.Logs {
+			problems := m.logProblems(l, now)
+			for name, p := range problems {
+				if _, ok := alerts[l][name]; !ok {
+					alerts[l][name] = p
+					m.publishHealth(l, name, p, false, now)
+				}
+			}
+			for name, p := range alerts[l] {
+				if _, ok := problems[name]; !ok {
+					delete(alerts[l], name)
+					m.publishHealth(l, name, logProblem{p.since, "Problem is gone"}, true, now)
+				}
+			}
+		}
+	}
+}
+
+func (m *MonCtx) publishHealth(l *LogState, problem string, p logProblem, resolved bool, now time.Time) {
+	h := &models.LogHealth{Log: l.Uri, LogID: l.LogID, Problem: problem, Resolved: resolved,
+		Severity: models.SeverityHigh, Message: p.message, Since: p.since.UTC(), Time: now}
+	if resolved {
+		h.Severity = models.SeverityLow
+	}
+	log.Printf("%s (%s)", h.Title(), h.Message)
+	m.bus.Publish(models.MonEvent{Type: models.CT_LOG_HEALTH, Log: l.Uri, LogID: l.LogID, Health: h})
+}
@@ -0,0 +1,285 @@
+package mon
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"golang.org/x/net/context"
+
+	"github.com/google/certificate-transparency/go"
+	"github.com/google/certificate-transparency/go/client"
+	"github.com/google/certificate-transparency/go/x509"
+	"github.com/kyprizel/certificate-transparency/go/scanner"
+
+	"github.com/kyprizel/ct_mon/pkg/metrics"
+)
+
+var (
+	treeSize      = metrics.NewGauge("ct_mon_log_tree_size", "Tree size of the latest log STH.", "log")
+	logIndex      = metrics.NewGauge("ct_mon_log_index", "Index of the next entry to scan.", "log")
+	logLag        = metrics.NewGauge("ct_mon_log_lag", "Entries behind the latest log STH.", "log")
+	logRate       = metrics.NewGauge("ct_mon_log_entries_per_second", "Scan throughput of the current scan.", "log")
+	logEntries    = metrics.NewCounter("ct_mon_log_entries_total", "Log entries scanned.", "log")
+	fetchErrors   = metrics.NewCounter("ct_mon_log_fetch_errors_total", "Failed log requests by HTTP status.", "log", "status")
+	parseFailures = metrics.NewCounter("ct_mon_log_parse_failures_total", "Log entries failed to parse.", "log")
+	ruleMatches   = metrics.NewCounter("ct_mon_matches_total", "Matched certificates by rule.", "rule")
+)
+
+/* log STH is polled for tree size between scans */
+const sthTimeout = 30 * time.Second
+
+type logMetrics struct {
+	uri      string
+	treeSize int64
+	index    int64
+	/* times in unix nanoseconds: last successful STH fetch, timestamp of the latest STH,
+	   last tree growth, first of consecutive STH fetch failures (0 after success) */
+	fetched int64
+	sthTime int64
+	grown   int64
+	failing int64
+
+	mu      sync.Mutex
+	scanner *scanner.Scanner
+	start   int64
+	counted int64
+	/* last index advance of the current scan */
+	progress time.Time
+	/* entries passed to matcher by the current scan, the rest failed to parse */
+	parsed int64
+}
+
+func newLogMetrics(l *LogState) *logMetrics {
+	lm := &logMetrics{uri: l.Uri, index: l.StartIndex}
+	treeSize.Func(func() float64 { return float64(atomic.LoadInt64(&lm.treeSize)) }, l.Uri)
+	logIndex.Func(func() float64 { return float64(atomic.LoadInt64(&lm.index)) }, l.Uri)
+	logLag.Func(lm.lag, l.Uri)
+	logRate.Set(0, l.Uri)
+	logEntries.Add(0, l.Uri)
+	parseFailures.Add(0, l.Uri)
+	return lm
+}
+
+func (lm *logMetrics) lag() float64 {
+	lag := atomic.LoadInt64(&lm.treeSize) - atomic.LoadInt64(&lm.index)
+	if lag < 0 {
+		return 0
+	}
+	return float64(lag)
+}
+
+func (lm *logMetrics) setFetched(t time.Time) {
+	for {
+		old := atomic.LoadInt64(&lm.fetched)
+		if t.UnixNano() <= old || atomic.CompareAndSwapInt64(&lm.fetched, old, t.UnixNano()) {
+			return
+		}
+	}
+}
+
+// sthAge returns time since the last successful STH fetch, ok is false if STH was never fetched.
+func (lm *logMetrics) sthAge() (age time.Duration, ok bool) {
+	fetched := atomic.LoadInt64(&lm.fetched)
+	if fetched == 0 {
+		return 0, false
+	}
+	return time.Since(time.Unix(0, fetched)), true
+}
+
+func (lm *logMetrics) setTreeSize(size uint64) {
+	for {
+		old := atomic.LoadInt64(&lm.treeSize)
+		if int64(size) <= old {
+			return
+		}
+		if atomic.CompareAndSwapInt64(&lm.treeSize, old, int64(size)) {
+			atomic.StoreInt64(&lm.grown, time.Now().UnixNano())
+			return
+		}
+	}
+}
+
+func (lm *logMetrics) setSTH(size uint64, timestamp uint64) {
+	lm.setTreeSize(size)
+	/* STH timestamp is in milliseconds */
+	ts := int64(timestamp) * int64(time.Millisecond)
+	for {
+		old := atomic.LoadInt64(&lm.sthTime)
+		if ts <= old || atomic.CompareAndSwapInt64(&lm.sthTime, old, ts) {
+			return
+		}
+	}
+}
+
+func loadTime(v *int64) time.Time {
+	ns := atomic.LoadInt64(v)
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ns)
+}
+
+// stuckSince returns last progress of the current scan if it's behind the STH, zero time otherwise.
+func (lm *logMetrics) stuckSince() time.Time {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	if lm.scanner == nil || lm.lag() == 0 {
+		return time.Time{}
+	}
+	return lm.progress
+}
+
+// matcher wraps |m| to count entries the scanner managed to parse.
+func (lm *logMetrics) matcher(m scanner.Matcher) scanner.Matcher {
+	return countingMatcher{Matcher: m, parsed: &lm.parsed}
+}
+
+func (lm *logMetrics) begin(s *scanner.Scanner, start int64) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	lm.scanner, lm.start, lm.counted = s, start, 0
+	lm.progress = time.Now()
+	atomic.StoreInt64(&lm.parsed, 0)
+}
+
+/* scanner doesn't stop tickers, so ticks of finished scans are ignored */
+func (lm *logMetrics) update(s *scanner.Scanner) int64 {
+	processed := atomic.LoadInt64(&s.CertsProcessed)
+	if processed > lm.counted {
+		lm.progress = time.Now()
+	}
+	logEntries.Add(float64(processed-lm.counted), lm.uri)
+	lm.counted = processed
+	atomic.StoreInt64(&lm.index, lm.start+processed)
+	return processed
+}
+
+func (lm *logMetrics) tick(s *scanner.Scanner, startTime time.Time, sth *ct.SignedTreeHead) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	if s != lm.scanner {
+		return
+	}
+	processed := lm.update(s)
+	/* scan starts right after fetching its STH */
+	lm.setFetched(startTime)
+	lm.setSTH(sth.TreeSize, sth.Timestamp)
+	logRate.Set(float64(processed)/time.Since(startTime).Seconds(), lm.uri)
+}
+
+func (lm *logMetrics) end(s *scanner.Scanner) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	processed := lm.update(s)
+	/* counted at the end only, entries in progress are not parsed yet during the scan */
+	if failed := processed - atomic.LoadInt64(&lm.parsed); failed > 0 {
+		parseFailures.Add(float64(failed), lm.uri)
+	}
+	logRate.Set(0, lm.uri)
+	lm.scanner = nil
+}
+
+/* counts failed requests by HTTP status, the scanner retries get-entries and only logs the errors */
+type statusTransport struct {
+	http.RoundTripper
+	uri string
+}
+
+func (t statusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err != nil {
+		fetchErrors.Inc(t.uri, "error")
+	} else if resp.StatusCode != http.StatusOK {
+		fetchErrors.Inc(t.uri, strconv.Itoa(resp.StatusCode))
+	}
+	return resp, err
+}
+
+// logClient returns log client of client.New that counts failed requests.
+func (lm *logMetrics) logClient() *client.LogClient {
+	c := client.New(lm.uri)
+	/* vendored client takes no http.Client, its transport is wrapped in place */
+	f := reflect.ValueOf(c).Elem().FieldByName("httpClient")
+	if !f.IsValid() || f.Type() != reflect.TypeOf(&http.Client{}) {
+		log.Printf("Can't count failed requests to %s, unknown log client", lm.uri)
+		return c
+	}
+	hc := reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem().Interface().(*http.Client)
+	hc.Transport = statusTransport{hc.Transport, lm.uri}
+	return c
+}
+
+// pollSTH updates tree size from log STH every |interval| until |ctx| is done.
+func (lm *logMetrics) pollSTH(ctx context.Context, interval time.Duration) {
+	c := &http.Client{Timeout: sthTimeout}
+	for {
+		lm.fetchSTH(c)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (lm *logMetrics) fetchSTH(c *http.Client) {
+	status := lm.getSTH(c)
+	if status != "" {
+		fetchErrors.Inc(lm.uri, status)
+		atomic.CompareAndSwapInt64(&lm.failing, 0, time.Now().UnixNano())
+		return
+	}
+	atomic.StoreInt64(&lm.failing, 0)
+	lm.setFetched(time.Now())
+}
+
+/* returns failure status, empty on success */
+func (lm *logMetrics) getSTH(c *http.Client) string {
+	resp, err := c.Get(lm.uri + client.GetSTHPath)
+	if err != nil {
+		return "error"
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return strconv.Itoa(resp.StatusCode)
+	}
+	var sth struct {
+		TreeSize  uint64 `json:"tree_size"`
+		Timestamp uint64 `json:"timestamp"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&sth); err != nil {
+		return "invalid"
+	}
+	lm.setSTH(sth.TreeSize, sth.Timestamp)
+	return ""
+}
+
+type countingMatcher struct {
+	scanner.Matcher
+	parsed *int64
+}
+
+func (m countingMatcher) CertificateMatches(c *x509.Certificate) bool {
+	atomic.AddInt64(m.parsed, 1)
+	return m.Matcher.CertificateMatches(c)
+}
+
+func (m countingMatcher) PrecertificateMatches(p *ct.Precertificate) bool {
+	atomic.AddInt64(m.parsed, 1)
+	return m.Matcher.PrecertificateMatches(p)
+}
+
+type MetricsTicker struct {
+	log *LogState
+}
+
+func (t MetricsTicker) HandleTick(s *scanner.Scanner, startTime time.Time, sth *ct.SignedTreeHead) {
+	t.log.metrics.tick(s, startTime, sth)
+}
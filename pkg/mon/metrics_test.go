@@ -0,0 +1,44 @@
+package mon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFetchErrors(t *testing.T) {
+	/* the first get-entries fails */
+	var failed int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.CompareAndSwapInt32(&failed, 0, 1) {
+			http.Error(w, "overloaded", http.StatusServiceUnavailable)
+			return
+		}
+		http.ServeFile(w, r, precertEntries)
+	}))
+	defer srv.Close()
+
+	lm := newLogMetrics(&LogState{Uri: srv.URL})
+	c := lm.logClient()
+	if _, err := c.GetEntries(0, 1); err == nil {
+		t.Fatal("no error from overloaded log")
+	}
+	if entries, err := c.GetEntries(0, 1); err != nil || len(entries) != 2 {
+		t.Fatalf("got %d entries (%v), want 2", len(entries), err)
+	}
+	if n := fetchErrors.Value(srv.URL, "503"); n != 1 {
+		t.Errorf("%v get-entries failures with status 503, want 1", n)
+	}
+	if n := fetchErrors.Value(srv.URL, "error"); n != 0 {
+		t.Errorf("%v connection errors, want 0", n)
+	}
+
+	srv.Close()
+	if _, err := c.GetEntries(0, 1); err == nil {
+		t.Fatal("no error from closed log")
+	}
+	if n := fetchErrors.Value(srv.URL, "error"); n != 1 {
+		t.Errorf("%v connection errors, want 1", n)
+	}
+}
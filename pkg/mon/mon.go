@@ -2,52 +2,146 @@ package mon
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
+	"net/http"
+	"sync"
 	"time"
 
 	"golang.org/x/net/context"
 
 	"github.com/google/certificate-transparency/go"
-	"github.com/google/certificate-transparency/go/client"
+	"github.com/google/certificate-transparency/go/x509"
 	"github.com/kyprizel/certificate-transparency/go/scanner"
 
 	"github.com/kyprizel/ct_mon/pkg/matcher"
 
 	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/api"
+	"github.com/kyprizel/ct_mon/pkg/bus"
+	"github.com/kyprizel/ct_mon/pkg/certs"
 	"github.com/kyprizel/ct_mon/pkg/db"
-	"github.com/kyprizel/ct_mon/pkg/mail"
+	"github.com/kyprizel/ct_mon/pkg/metrics"
+	"github.com/kyprizel/ct_mon/pkg/notify"
+	"github.com/kyprizel/ct_mon/pkg/retention"
+	"github.com/kyprizel/ct_mon/pkg/web"
+	"github.com/kyprizel/ct_mon/utils"
 )
 
-type MonConfig struct {
-	LogUri            string   `json:"log_uri"`
+type LogConfig struct {
+	Uri        string `json:"uri"`
+	Key        string `json:"key"`
+	StartIndex int64  `json:"start_index"`
+	/* maximum merge delay in seconds */
+	MMD int `json:"mmd"`
+}
+
+type RuleConfig struct {
+	Name              string   `json:"name"`
 	MatchSubjectRegex string   `json:"match_subject_regex"`
-	MatchSubjectFuzzy []string   `json:"match_subject_fuzzy"`
-	BatchSize         int      `json:"batch_size"`
-	NumWorkers        int      `json:"num_workers"`
-	ParallelFetch     int      `json:"parallel_fetch"`
-	MongoURI          string   `json:"mongo_uri"`
-	StoreMatches      bool     `json:"store_matches"`
-	Emails            []string `json:"notify_persons"`
-	SMTPHost          string   `json:"smtp_host"`
-	SMTPPort          int      `json:"smtp_port"`
-	SMTPUser          string   `json:"smtp_user"`
-	SMTPPasswd        string   `json:"smtp_password"`
-	SMTPSubj          string   `json:"smtp_subject"`
-	SMTPFrom          string   `json:"smtp_from"`
-	NotifyMatches     bool     `json:"notify_on_match"`
-	StartIndex        int64    `json:"start_index"`
-	CAWhitelist       []string `json:"ca_whitelist"`
-	Verbose           bool     `json:"verbose"`
-	TickTime          int      `json:"save_state"`
-	RescanPeriod      int      `json:"rescan_period"`
+	MatchSubjectFuzzy []string `json:"match_subject_fuzzy"`
+	Severity          string   `json:"severity"`
 }
 
-type MonCtx struct {
+type MonConfig struct {
+	LogUri            string            `json:"log_uri"`
+	Logs              []LogConfig       `json:"logs"`
+	MatchSubjectRegex string            `json:"match_subject_regex"`
+	MatchSubjectFuzzy []string          `json:"match_subject_fuzzy"`
+	Rules             []RuleConfig      `json:"rules"`
+	Sinks             []json.RawMessage `json:"sinks"`
+	BatchSize         int               `json:"batch_size"`
+	NumWorkers        int               `json:"num_workers"`
+	ParallelFetch     int               `json:"parallel_fetch"`
+	MongoURI          string            `json:"mongo_uri"`
+	DBType            string            `json:"db_type"`
+	DBURI             string            `json:"db_uri"`
+	StoreMatches      bool              `json:"store_matches"`
+	Emails            []string          `json:"notify_persons"`
+	SMTPHost          string            `json:"smtp_host"`
+	SMTPPort          int               `json:"smtp_port"`
+	SMTPUser          string            `json:"smtp_user"`
+	SMTPPasswd        string            `json:"smtp_password"`
+	SMTPSubj          string            `json:"smtp_subject"`
+	SMTPFrom          string            `json:"smtp_from"`
+	SMTPTLS           string            `json:"smtp_tls"`
+	SMTPCAFile        string            `json:"smtp_ca_file"`
+	SMTPServerName    string            `json:"smtp_server_name"`
+	SMTPAuth          string            `json:"smtp_auth"`
+	SMIMECert         string            `json:"smime_cert"`
+	SMIMEKey          string            `json:"smime_key"`
+	SMIMEEncrypt      []string          `json:"smime_encrypt_certs"`
+	PGPKey            string            `json:"pgp_key"`
+	PGPPassphrase     string            `json:"pgp_passphrase"`
+	PGPEncrypt        string            `json:"pgp_encrypt_keys"`
+	DigestWindow      int               `json:"digest_window"`
+	DigestSize        int               `json:"digest_size"`
+	ImmediateSeverity string            `json:"immediate_severity"`
+	Outbox            bool              `json:"outbox"`
+	OutboxMaxAttempts int               `json:"outbox_max_attempts"`
+	OutboxKeepDays    int               `json:"outbox_keep_days"`
+	NotifyMatches     bool              `json:"notify_on_match"`
+	StartIndex        int64             `json:"start_index"`
+	CAWhitelist       []string          `json:"ca_whitelist"`
+	Verbose           bool              `json:"verbose"`
+	TickTime          int               `json:"save_state"`
+	RescanPeriod      int               `json:"rescan_period"`
+	StateFile         string            `json:"state_file"`
+	JSONLOutput       string            `json:"jsonl_output"`
+	JSONLMaxSize      int64             `json:"jsonl_max_size"`
+	JSONLMaxBackups   int               `json:"jsonl_max_backups"`
+	RetentionExpired  int               `json:"retention_expired_days"`
+	RetentionSupp     int               `json:"retention_suppressed_days"`
+	RetentionPeriod   int               `json:"retention_period"`
+	ArchiveDir        string            `json:"archive_dir"`
+	MetricsListen     string            `json:"metrics_listen"`
+	MetricsSTHPeriod  int               `json:"metrics_sth_period"`
+	StuckTimeout      int               `json:"health_stuck_timeout"`
+	ReadySTHAge       int               `json:"ready_sth_age"`
+	ReadyMaxLag       int64             `json:"ready_max_lag"`
+	LogHealth         bool              `json:"log_health"`
+	LogStallPeriod    int               `json:"log_stall_period"`
+	LogErrorPeriod    int               `json:"log_error_period"`
+	APIListen         string            `json:"api_listen"`
+	APIToken          string            `json:"api_token"`
+	Dashboard         bool              `json:"dashboard"`
+}
+
+type LogState struct {
+	Uri        string
+	LogID      string
 	StartIndex int64
-	Handlers   []chan models.MonEvent
+	MMD        time.Duration
+	metrics    *logMetrics
+
+	/* guards the fields below and StartIndex during the scan */
+	mu      sync.Mutex
+	scanner *scanner.Scanner
+	/* entries of the current pass handled above StartIndex */
+	spans []span
+	/* lowest index of a match not handed to sinks, -1 if none */
+	held    int64
+	stopped bool
+	/* serializes state saves, so the last saved checkpoint is the latest computed */
+	saveMu sync.Mutex
+}
+
+type MonCtx struct {
+	Logs       []*LogState
+	bus        *bus.Bus
 	conf       *MonConfig
-	db         *db.MonDB
+	db         db.Store
+	state      db.StateStore
+	dedup      *Deduper
+	correlator *Correlator
+	rules      matcher.RuleMatcher
+	sinks      []*notify.Handler
+	outbox     *notify.Outbox
+	/* handleEntry holds read lock while handing matches to sinks */
+	scanMu  sync.RWMutex
+	stopped bool
 }
 
 func New() (*MonCtx, error) {
@@ -55,30 +149,70 @@ func New() (*MonCtx, error) {
 	return c, nil
 }
 
-func (ctx *MonCtx) SetConfig(fileName string, verbose bool) error {
+func ReadConfig(fileName string) (*MonConfig, error) {
 	file, err := ioutil.ReadFile(fileName)
 	if err != nil {
-		log.Fatal(err)
-		return nil
+		return nil, err
 	}
 
 	var conf MonConfig
 	err = json.Unmarshal(file, &conf)
+	if err != nil {
+		return nil, err
+	}
+	return &conf, nil
+}
+
+func (ctx *MonCtx) SetConfig(fileName string, verbose bool) error {
+	c, err := ReadConfig(fileName)
 	if err != nil {
 		log.Fatal(err)
 		return nil
 	}
+	conf := *c
 
 	var isBadSMTPConf bool
 	var isBadDBConf bool
 
-	if conf.LogUri == "" {
-		conf.LogUri = "http://ct.googleapis.com/aviator"
+	/* single log_uri/start_index config is the same as one entry in logs */
+	legacyLog := len(conf.Logs) == 0
+	if legacyLog {
+		if conf.LogUri == "" {
+			conf.LogUri = "http://ct.googleapis.com/aviator"
+		}
+		conf.Logs = []LogConfig{{Uri: conf.LogUri, StartIndex: conf.StartIndex}}
 	}
 
-	if conf.MatchSubjectRegex == "" {
-		log.Fatal("Invalid monitoring regexp, use .* to match everything (a lot!)")
-		return nil
+	/* single match_subject_regex config is the same as one rule */
+	if len(conf.Rules) == 0 {
+		conf.Rules = []RuleConfig{{Name: "default", MatchSubjectRegex: conf.MatchSubjectRegex,
+			MatchSubjectFuzzy: conf.MatchSubjectFuzzy}}
+	}
+
+	CNset := make(map[string]bool)
+	for _, v := range conf.CAWhitelist {
+		CNset[v] = true
+	}
+
+	for i, rc := range conf.Rules {
+		if rc.MatchSubjectRegex == "" {
+			log.Fatal("Invalid monitoring regexp, use .* to match everything (a lot!)")
+			return nil
+		}
+		if rc.Name == "" {
+			rc.Name = fmt.Sprintf("rule%d", i+1)
+		}
+		severity, err := models.ParseSeverity(rc.Severity)
+		if err != nil {
+			log.Fatalf("Invalid rule %s (%v)", rc.Name, err)
+		}
+		m, err := matcher.CreateMatcherFromFlags(rc.MatchSubjectRegex, CNset, rc.MatchSubjectFuzzy)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx.rules.Rules = append(ctx.rules.Rules, matcher.Rule{Name: rc.Name, Severity: severity,
+			Matcher: m.(matcher.MatchSubjectRegexUnkCA)})
+		ruleMatches.Add(0, rc.Name)
 	}
 
 	if conf.BatchSize == 0 {
@@ -98,8 +232,16 @@ func (ctx *MonCtx) SetConfig(fileName string, verbose bool) error {
 		isBadSMTPConf = true
 	}
 
-	if conf.MongoURI == "" {
-		log.Println("No database configured, state will not be saved")
+	/* mongo_uri is the same as db_type mongo */
+	if conf.DBURI == "" && conf.MongoURI != "" {
+		conf.DBType = "mongo"
+		conf.DBURI = conf.MongoURI
+	}
+
+	if conf.DBURI == "" {
+		if conf.StateFile == "" {
+			log.Println("No database or state file configured, state will not be saved")
+		}
 		isBadDBConf = true
 	}
 
@@ -121,7 +263,6 @@ func (ctx *MonCtx) SetConfig(fileName string, verbose bool) error {
 		conf.SMTPSubj = "Certificate Transparency monitor notification"
 	}
 
-
 	if conf.NotifyMatches {
 		if isBadSMTPConf {
 			log.Fatal("No SMTP configured, can't notificate about matches")
@@ -137,26 +278,50 @@ func (ctx *MonCtx) SetConfig(fileName string, verbose bool) error {
 		conf.TickTime = 30
 	}
 
-	var startIndex int64
 	/* Init DB connection */
-	if conf.MongoURI != "" {
-		ctx.db, err = db.Init(conf.MongoURI)
-		if err != nil || ctx.db == nil {
+	if conf.DBURI != "" {
+		store, err := db.Open(conf.DBType, conf.DBURI)
+		if err != nil {
 			isBadDBConf = true
+		} else {
+			ctx.db = store
 		}
+	}
+
+	if ctx.db != nil {
+		ctx.state = ctx.db
+	} else if conf.StateFile != "" {
+		ctx.state = db.NewFileState(conf.StateFile)
+	}
 
-		if err == nil {
-			/* Load last index state from DB */
-			startIndex, err = ctx.db.LoadState()
+	for _, lc := range conf.Logs {
+		l := &LogState{Uri: lc.Uri, MMD: defaultMMD, held: -1}
+		if lc.MMD > 0 {
+			l.MMD = time.Duration(lc.MMD) * time.Second
+		}
+		if lc.Key != "" {
+			l.LogID, err = certs.LogID(lc.Key)
+			if err != nil {
+				log.Fatalf("Invalid key of log %s (%v)", lc.Uri, err)
+			}
+		}
+		if ctx.state != nil {
+			/* Load last index state */
+			startIndex, err := ctx.state.LoadState(lc.Uri)
+			if err != nil && legacyLog {
+				startIndex, err = ctx.state.LoadState("")
+			}
 			if err == nil {
-				ctx.StartIndex = startIndex
+				l.StartIndex = startIndex
 			}
 		}
-	}
 
-	/* Override index if set via config */
-	if conf.StartIndex > ctx.StartIndex {
-		ctx.StartIndex = conf.StartIndex
+		/* Override index if set via config */
+		if lc.StartIndex > l.StartIndex {
+			l.StartIndex = lc.StartIndex
+		}
+		l.metrics = newLogMetrics(l)
+		ctx.Logs = append(ctx.Logs, l)
 	}
 
 	if conf.StoreMatches {
@@ -165,104 +330,391 @@ func (ctx *MonCtx) SetConfig(fileName string, verbose bool) error {
 		}
 	}
 
-	if isBadSMTPConf && isBadDBConf {
-		log.Fatal("No notifications or DB configured, no reason to start")
+	if conf.JSONLMaxBackups == 0 {
+		conf.JSONLMaxBackups = 5
+	}
+
+	if conf.MetricsSTHPeriod <= 0 {
+		conf.MetricsSTHPeriod = 60
+	}
+
+	if conf.StuckTimeout <= 0 {
+		conf.StuckTimeout = 300
+	}
+
+	if conf.LogStallPeriod <= 0 {
+		conf.LogStallPeriod = 86400
+	}
+
+	if conf.LogErrorPeriod <= 0 {
+		conf.LogErrorPeriod = 3600
+	}
+
+	if conf.ReadySTHAge <= 0 {
+		conf.ReadySTHAge = 10
+	}
+
+	if conf.RetentionPeriod <= 0 {
+		conf.RetentionPeriod = 3600
+	}
+
+	env := &notify.Env{Store: ctx.db}
+	if len(conf.Sinks) == 0 {
+		/* top-level store_matches, notify_on_match and jsonl_output configs are sinks without filters */
+		legacy, err := json.Marshal(&conf)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if ctx.db != nil && conf.StoreMatches {
+			ctx.addSink(notify.NewWithFilter("db", notify.Filter{Suppressed: true}, legacy, env))
+		}
+		if conf.NotifyMatches {
+			ctx.addSink(notify.NewWithFilter("mail", notify.Filter{}, legacy, env))
+		}
+		if conf.JSONLOutput != "" {
+			ctx.addSink(notify.NewWithFilter("jsonl", notify.Filter{Suppressed: true}, legacy, env))
+		}
+	}
+	for _, sc := range conf.Sinks {
+		ctx.addSink(notify.New(sc, env))
+	}
+
+	names := make(map[string]bool)
+	for _, h := range ctx.sinks {
+		if names[h.Name] {
+			log.Fatalf("Duplicate sink name %s, set unique name for sinks of the same type", h.Name)
+		}
+		names[h.Name] = true
+	}
+
+	if conf.Outbox {
+		if ctx.db == nil {
+			log.Fatal("No DB configured, can't keep notification outbox")
+		}
+		if conf.OutboxMaxAttempts <= 0 {
+			conf.OutboxMaxAttempts = 10
+		}
+		if conf.OutboxKeepDays <= 0 {
+			conf.OutboxKeepDays = 7
+		}
+		ctx.outbox = notify.NewOutbox(ctx.db, conf.OutboxMaxAttempts, time.Minute,
+			time.Duration(conf.OutboxKeepDays)*24*time.Hour)
+		/* stored matches don't need delivery tracking */
+		for _, h := range ctx.sinks {
+			if h.Type != "db" {
+				ctx.outbox.Register(h)
+			}
+		}
+	}
+
+	if conf.APIListen != "" && ctx.db == nil {
+		log.Fatal("No DB configured, can't serve API")
+	}
+
+	if conf.Dashboard && conf.APIListen == "" {
+		log.Fatal("Dashboard is served on api_listen, set it")
+	}
+
+	if len(ctx.sinks) == 0 && isBadDBConf {
+		log.Fatal("No notifications, DB or JSONL output configured, no reason to start")
 	}
 
 	ctx.conf = &conf
 	return nil
 }
 
+func (ctx *MonCtx) addSink(h *notify.Handler, err error) {
+	if err != nil {
+		log.Fatalf("Invalid sink config (%v)", err)
+	}
+	ctx.sinks = append(ctx.sinks, h)
+}
+
 func (m *MonCtx) Serve(ctx context.Context) error {
-	logClient := client.New(m.conf.LogUri)
+	if m.conf.MetricsListen != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		mux.HandleFunc("/healthz", m.healthz)
+		mux.HandleFunc("/readyz", m.readyz)
+		ln, err := listen(m.conf.MetricsListen, mux)
+		if err != nil {
+			return err
+		}
+		defer ln.Close()
+	}
 
-	CNset := make(map[string]bool)
-	for _, v := range m.conf.CAWhitelist {
-		CNset[v] = true
+	if m.conf.MetricsListen != "" || m.conf.LogHealth {
+		for _, l := range m.Logs {
+			go l.metrics.pollSTH(ctx, time.Duration(m.conf.MetricsSTHPeriod)*time.Second)
+		}
+	}
+
+	m.bus = bus.New()
+	for _, h := range m.sinks {
+		ch := m.bus.Subscribe(h.Name, h.QueueSize, h.Drop)
+		go h.HandleEvents(ch)
+	}
+	if m.conf.LogHealth {
+		go m.watchLogs(ctx)
+	}
+
+	/* triage changes and escalations are sent through the bus */
+	if m.conf.APIListen != "" {
+		if m.conf.APIToken == "" {
+			log.Printf("api_token is not set, API and dashboard at %s are read-only", m.conf.APIListen)
+		}
+		mux := http.NewServeMux()
+		mux.Handle(api.Prefix, api.New(m.db, m.conf.APIToken, m.triage))
+		if m.conf.Dashboard {
+			mux.Handle(web.Prefix, web.New(m.db, m.conf.APIToken, m.logStatus, m.triage, m.escalate))
+		}
+		ln, err := listen(m.conf.APIListen, mux)
+		if err != nil {
+			return err
+		}
+		defer ln.Close()
+	}
+
+	if m.db != nil && (m.conf.RetentionExpired > 0 || m.conf.RetentionSupp > 0) {
+		policy := &retention.Policy{Store: m.db,
+			Expired:    time.Duration(m.conf.RetentionExpired) * 24 * time.Hour,
+			Suppressed: time.Duration(m.conf.RetentionSupp) * 24 * time.Hour,
+			ArchiveDir: m.conf.ArchiveDir,
+			Interval:   time.Duration(m.conf.RetentionPeriod) * time.Second,
+			Verbose:    m.conf.Verbose}
+		go policy.Serve(ctx)
+	}
+
+	if m.outbox != nil {
+		go m.outbox.Serve(ctx)
+	}
+
+	m.dedup = NewDeduper(m.db)
+	m.correlator = NewCorrelator(m.db)
+
+	var promises utils.Promises
+	for _, l := range m.Logs {
+		l := l
+		promises = append(promises, utils.Promise(func() error { return m.scanLog(ctx, l) }))
+	}
+
+	var err error
+	select {
+	case err = <-promises.All():
+	case <-ctx.Done():
+		log.Print("Stopping, scan resumes from the first entry not handled")
+	}
+
+	m.stopScans()
+	/* sinks drain their queues, flush digests and close connections */
+	m.bus.Close()
+	for _, h := range m.sinks {
+		h.Wait()
 	}
+	return err
+}
 
-	matcher, err := matcher.CreateMatcherFromFlags(m.conf.MatchSubjectRegex, CNset, m.conf.MatchSubjectFuzzy)
+/* listening before the scan starts, so a bad address fails early */
+func listen(addr string, h http.Handler) (net.Listener, error) {
+	ln, err := net.Listen("tcp", addr)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
+	go http.Serve(ln, h)
+	return ln, nil
+}
+
+func (m *MonCtx) scanLog(ctx context.Context, l *LogState) error {
+	logClient := l.metrics.logClient()
 
 	opts := scanner.DefaultScannerOptions()
-	opts.Matcher = matcher
+	/* every entry is handed to handleEntry, so the checkpoint knows which ones are done */
+	opts.Matcher = l.metrics.matcher(scanner.MatchAll{})
 	opts.BatchSize = m.conf.BatchSize
 	opts.NumWorkers = m.conf.NumWorkers
 	opts.ParallelFetch = m.conf.ParallelFetch
-	opts.StartIndex = m.StartIndex
+	opts.StartIndex = l.StartIndex
 	opts.TickTime = time.Duration(m.conf.TickTime) * time.Second
-	opts.Tickers = []scanner.Ticker{scanner.LogTicker{}}
+	opts.Tickers = []scanner.Ticker{scanner.LogTicker{}, MetricsTicker{log: l}}
 	opts.Quiet = !m.conf.Verbose
-	if m.db != nil {
-		opts.Tickers = append(opts.Tickers, StateSaverTicker{mon: m})
-	}
-
-	if m.db != nil && m.conf.StoreMatches {
-		ch := make(chan models.MonEvent)
-		m.Handlers = append(m.Handlers, ch)
-		dbWorker := db.CertHandler{DB: m.db}
-		go dbWorker.HandleEvents(ch)
-	}
-
-	if m.conf.NotifyMatches {
-		ch := make(chan models.MonEvent)
-		m.Handlers = append(m.Handlers, ch)
-		smtpWorker := mail.CertHandler{Emails: m.conf.Emails, Host: m.conf.SMTPHost,
-			Port: m.conf.SMTPPort, User: m.conf.SMTPUser,
-			Password: m.conf.SMTPPasswd,
-			From:     m.conf.SMTPFrom, Subj: m.conf.SMTPSubj}
-		go smtpWorker.HandleEvents(ch)
+	if m.state != nil {
+		opts.Tickers = append(opts.Tickers, StateSaverTicker{mon: m, log: l})
 	}
 
 	for {
 		scanner := scanner.NewScanner(logClient, *opts)
-		err = scanner.Scan(func(entry *ct.LogEntry) {
-			for _, ch := range m.Handlers {
-				e := models.MonEvent{Type: models.CT_CERT, LogEntry: entry}
-				ch <- e
-			}
+		l.start(scanner)
+		l.metrics.begin(scanner, opts.StartIndex)
+		err := scanner.Scan(func(entry *ct.LogEntry) {
+			m.handleEntry(l, models.CT_CERT, entry)
 		}, func(entry *ct.LogEntry) {
-			for _, ch := range m.Handlers {
-				e := models.MonEvent{Type: models.CT_PRECERT, LogEntry: entry}
-				ch <- e
-			}
+			m.handleEntry(l, models.CT_PRECERT, entry)
 		})
+		l.metrics.end(scanner)
+		if err != nil {
+			log.Printf("Scan of %s failed (%v)", l.Uri, err)
+		}
+
+		/* do not fetch from old startindex in cycle */
+		opts.StartIndex = l.advance(scanner)
+		m.saveState(l, l.resume)
 
 		if m.conf.RescanPeriod <= 0 {
 			break
 		}
 		if m.conf.Verbose {
-			log.Print("Scan complete sleeping...")
+			log.Printf("Scan of %s complete sleeping...", l.Uri)
 		}
-		/* do not fetch from old startindex in cycle */
-		opts.StartIndex = m.StartIndex + int64(scanner.CertsProcessed)
 
-		time.Sleep(time.Duration(m.conf.RescanPeriod) * time.Second)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Duration(m.conf.RescanPeriod) * time.Second):
+		}
 	}
+	return nil
+}
 
-	for _, ch := range m.Handlers {
-		e := models.MonEvent{Type: models.CT_QUIT, LogEntry: nil}
-		ch <- e
+/* scanner can't be interrupted, after stopScans its matches only hold the checkpoint */
+func (m *MonCtx) stopScans() {
+	m.scanMu.Lock()
+	m.stopped = true
+	for _, l := range m.Logs {
+		l.stop()
 	}
+	m.scanMu.Unlock()
 
-	return nil
+	for _, l := range m.Logs {
+		m.saveState(l, l.resume)
+	}
+}
+
+func (m *MonCtx) matches(typ models.CTLogEntryType, entry *ct.LogEntry) bool {
+	if typ == models.CT_PRECERT {
+		return m.rules.PrecertificateMatches(entry.Precert)
+	}
+	return m.rules.CertificateMatches(entry.X509Cert)
+}
+
+func (m *MonCtx) handleEntry(l *LogState, typ models.CTLogEntryType, entry *ct.LogEntry) {
+	if !m.matches(typ, entry) {
+		l.handled(entry.Index)
+		return
+	}
+	m.scanMu.RLock()
+	defer m.scanMu.RUnlock()
+	if m.stopped {
+		l.hold(entry.Index)
+		m.saveState(l, l.resume)
+		return
+	}
+
+	e := models.MonEvent{Type: typ, LogEntry: entry, Log: l.Uri, LogID: l.LogID,
+		Fingerprint: certs.Fingerprint(entry)}
+
+	s := db.Sighting{Log: l.Uri, Index: entry.Index, Timestamp: certs.Timestamp(entry)}
+	if !m.dedup.Claim(e.Fingerprint) {
+		m.dedup.Add(e.Fingerprint, typ == models.CT_PRECERT, s)
+		l.handled(entry.Index)
+		return
+	}
+	defer m.dedup.Release(e.Fingerprint)
+	e.Suppressed = m.suppressed(e.Fingerprint, certs.Leaf(entry))
+	e.Rules, e.Severity = m.rules.Hits(certs.Leaf(entry))
+	for _, r := range e.Rules {
+		ruleMatches.Inc(r)
+	}
+
+	switch typ {
+	case models.CT_CERT:
+		e.TBSHash, e.FinalForPrecert = m.correlator.Cert(l.Uri, entry)
+	case models.CT_PRECERT:
+		e.TBSHash = m.correlator.Precert(l.Uri, entry)
+	}
+	/* match not in outbox isn't sent, it's handled again on rescan */
+	var err error
+	if e.Outbox, err = m.enqueue(e, notify.NewMatch(e)); err != nil {
+		for _, id := range e.Outbox {
+			m.outbox.Cancel(id)
+		}
+		l.hold(entry.Index)
+		return
+	}
+	m.publish(e)
+	m.dedup.Add(e.Fingerprint, typ == models.CT_PRECERT, s)
+	l.handled(entry.Index)
+}
+
+/* escalation is sent even if the outbox is down, analyst asked for it */
+func (m *MonCtx) escalate(match *models.Match) {
+	e := models.MonEvent{Type: models.CT_ESCALATION, Log: match.Log, LogID: match.LogID,
+		Fingerprint: match.Fingerprint, Match: match}
+	e.Outbox, _ = m.enqueue(e, match)
+	m.publish(e)
+}
+
+/* stores notifications of |match| to sinks before |e| is published */
+func (m *MonCtx) enqueue(e models.MonEvent, match *models.Match) (map[string]string, error) {
+	if m.outbox == nil {
+		return nil, nil
+	}
+	ids, err := m.outbox.Enqueue(m.sinks, match)
+	if err != nil {
+		log.Printf("Can't store notification of %s (%v)", e.Fingerprint, err)
+	}
+	return ids, err
+}
+
+func (m *MonCtx) publish(e models.MonEvent) {
+	/* dropped events are retried from the outbox */
+	for _, name := range m.bus.Publish(e) {
+		if id := e.Outbox[name]; id != "" {
+			m.outbox.Release(id)
+		}
+	}
+}
+
+func (m *MonCtx) suppressed(fingerprint string, c *x509.Certificate) bool {
+	if m.db == nil {
+		return false
+	}
+	suppressions, err := m.db.Suppressions()
+	if err != nil {
+		log.Printf("Can't load suppressions (%v)", err)
+		return false
+	}
+	names := append([]string{c.Subject.CommonName}, c.DNSNames...)
+	return db.Suppressed(suppressions, fingerprint, names)
 }
 
 type StateSaverTicker struct {
 	mon *MonCtx
+	log *LogState
 }
 
 func (t StateSaverTicker) HandleTick(s *scanner.Scanner, startTime time.Time, sth *ct.SignedTreeHead) {
-	if t.mon.db == nil {
+	if t.mon.state == nil {
 		return
 	}
 	if t.mon.conf.Verbose {
-		log.Print("Saving state to database...\n")
+		log.Print("Saving state...\n")
 	}
-	err := t.mon.db.SaveState(t.mon.StartIndex + int64(s.CertsProcessed))
-	if err != nil {
-		log.Print("Can't save state (%v)", err)
+	/* scanner doesn't stop tickers, ticks of finished and stopped scans are ignored */
+	t.mon.saveState(t.log, func() (int64, bool) { return t.log.checkpoint(s) })
+}
+
+/* saves checkpoint returned by |index| unless it's not ok */
+func (m *MonCtx) saveState(l *LogState, index func() (int64, bool)) {
+	if m.state == nil {
+		return
+	}
+	l.saveMu.Lock()
+	defer l.saveMu.Unlock()
+	next, ok := index()
+	if !ok {
+		return
+	}
+	if err := m.state.SaveState(l.Uri, next); err != nil {
+		log.Printf("Can't save state (%v)", err)
 	}
 }
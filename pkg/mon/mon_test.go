@@ -0,0 +1,216 @@
+package mon
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyprizel/certificate-transparency/go/scanner"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/bus"
+	"github.com/kyprizel/ct_mon/pkg/certs"
+	"github.com/kyprizel/ct_mon/pkg/cttest"
+	"github.com/kyprizel/ct_mon/pkg/db"
+	"github.com/kyprizel/ct_mon/pkg/matcher"
+	"github.com/kyprizel/ct_mon/pkg/notify"
+)
+
+/* get-entries of a precertificate (index 0) and its final certificate (index 1) */
+const precertEntries = "../certs/testdata/precert-entries.json"
+
+/* outbox storage failing on demand */
+type flakyStore struct {
+	db.Store
+	fail bool
+}
+
+func (s *flakyStore) EnqueueNotification(n *db.Notification) error {
+	if s.fail {
+		return errors.New("outbox is down")
+	}
+	return s.Store.EnqueueNotification(n)
+}
+
+func testMon(store db.Store) (*MonCtx, *LogState, chan models.MonEvent) {
+	all := matcher.MatchSubjectRegexUnkCA{CertificateSubjectRegex: regexp.MustCompile(""),
+		PrecertificateSubjectRegex: regexp.MustCompile("")}
+	m := &MonCtx{conf: &MonConfig{}, db: store, dedup: NewDeduper(store), correlator: NewCorrelator(nil),
+		bus: bus.New(), outbox: notify.NewOutbox(store, 3, time.Hour, time.Hour),
+		rules: matcher.RuleMatcher{Rules: []matcher.Rule{{Name: "all", Matcher: all}}}}
+	h := &notify.Handler{Name: "webhook", Type: "webhook"}
+	m.outbox.Register(h)
+	m.sinks = []*notify.Handler{h}
+	ch := m.bus.Subscribe(h.Name, 10, false)
+	l := &LogState{Uri: "https://log.example.com/", held: -1}
+	return m, l, ch
+}
+
+func TestHandleEntryOutboxFailure(t *testing.T) {
+	entries := cttest.Entries(t, precertEntries)
+	sqlite, err := db.InitSQL("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlite.Close()
+	store := &flakyStore{Store: sqlite, fail: true}
+	m, l, ch := testMon(store)
+	fingerprint := certs.Fingerprint(entries[0])
+
+	m.handleEntry(l, models.CT_PRECERT, entries[0])
+	select {
+	case e := <-ch:
+		t.Errorf("match not in outbox is published: %+v", e)
+	default:
+	}
+	if _, err := store.FindSightings(fingerprint); err != db.ErrNotFound {
+		t.Errorf("sighting of match not in outbox is stored (%v)", err)
+	}
+	if next := l.next(); next != 0 {
+		t.Errorf("checkpoint %d passes match not in outbox", next)
+	}
+
+	/* rescan after the outbox is back */
+	store.fail = false
+	l.advance(&scanner.Scanner{})
+	m.handleEntry(l, models.CT_PRECERT, entries[0])
+	if e := <-ch; e.Outbox["webhook"] == "" {
+		t.Error("match is not in outbox")
+	}
+	select {
+	case e := <-ch:
+		t.Errorf("match is published twice: %+v", e)
+	default:
+	}
+	if info, err := store.FindSightings(fingerprint); err != nil || len(info.Sightings) != 1 {
+		t.Errorf("sightings %+v (%v)", info, err)
+	}
+	if next := l.next(); next != 1 {
+		t.Errorf("checkpoint %d, want 1", next)
+	}
+
+	/* seen in another log */
+	other := &LogState{Uri: "https://other.example.com/", held: -1}
+	m.handleEntry(other, models.CT_PRECERT, entries[0])
+	select {
+	case e := <-ch:
+		t.Errorf("seen certificate published again: %+v", e)
+	default:
+	}
+	if info, err := store.FindSightings(fingerprint); err != nil || len(info.Sightings) != 2 {
+		t.Errorf("sightings %+v (%v)", info, err)
+	}
+}
+
+func TestHandleEntryConcurrent(t *testing.T) {
+	entries := cttest.Entries(t, precertEntries)
+	store, err := db.InitSQL("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	m, _, ch := testMon(store)
+
+	/* the same certificate is fetched from several logs at once */
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		l := &LogState{Uri: fmt.Sprintf("https://log%d.example.com/", i), held: -1}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.handleEntry(l, models.CT_PRECERT, entries[0])
+		}()
+	}
+	wg.Wait()
+	if n := len(ch); n != 1 {
+		t.Errorf("certificate published %d times, want 1", n)
+	}
+	if info, err := store.FindSightings(certs.Fingerprint(entries[0])); err != nil || len(info.Sightings) != 8 {
+		t.Errorf("sightings %+v (%v)", info, err)
+	}
+}
+
+func TestStopScans(t *testing.T) {
+	entries := cttest.Entries(t, precertEntries)
+	store, err := db.InitSQL("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	m, l, ch := testMon(store)
+	m.state = store
+	m.Logs = []*LogState{l}
+
+	/* the scanner counts both entries, the final certificate is handled first */
+	s := &scanner.Scanner{CertsProcessed: 2}
+	l.start(s)
+	m.handleEntry(l, models.CT_CERT, entries[1])
+	<-ch
+	if next, _ := l.checkpoint(s); next != 0 {
+		t.Errorf("checkpoint %d passes entry not handled", next)
+	}
+	m.stopScans()
+	if index, err := store.LoadState(l.Uri); err != nil || index != 0 {
+		t.Fatalf("checkpoint on stop %d (%v), want 0", index, err)
+	}
+	if _, ok := l.checkpoint(s); ok {
+		t.Error("stopped scan ticks save state")
+	}
+
+	/* the precert reaches handleEntry after stop */
+	m.handleEntry(l, models.CT_PRECERT, entries[0])
+	select {
+	case e := <-ch:
+		t.Errorf("match published after stop: %+v", e)
+	default:
+	}
+	if _, err := store.FindSightings(certs.Fingerprint(entries[0])); err != db.ErrNotFound {
+		t.Errorf("sighting of match not sent is stored (%v)", err)
+	}
+	if index, err := store.LoadState(l.Uri); err != nil || index != 0 {
+		t.Errorf("checkpoint %d (%v) passes match not sent", index, err)
+	}
+	/* scan finishing after stop keeps it */
+	if next := l.advance(s); next != 0 {
+		t.Errorf("checkpoint after stopped scan %d, want 0", next)
+	}
+}
+
+func TestCheckpoint(t *testing.T) {
+	l := &LogState{StartIndex: 10, held: -1}
+	s := &scanner.Scanner{CertsProcessed: 8}
+	l.start(s)
+
+	/* entries are handled out of order */
+	for _, tt := range []struct {
+		index int64
+		next  int64
+	}{
+		{12, 10}, {10, 11}, {15, 11}, {5, 11}, {11, 13}, {14, 13}, {12, 13}, {13, 16}, {17, 16},
+	} {
+		l.handled(tt.index)
+		if next, ok := l.checkpoint(s); !ok || next != tt.next {
+			t.Errorf("checkpoint after %d is %d, want %d", tt.index, next, tt.next)
+		}
+	}
+	if n := len(l.spans); n != 2 {
+		t.Errorf("%d spans %v, want 2", n, l.spans)
+	}
+
+	/* the pass is done, entries not handled failed to parse */
+	l.hold(19)
+	if next := l.advance(s); next != 18 {
+		t.Errorf("checkpoint after pass %d, want 18", next)
+	}
+	l.start(s)
+	l.hold(20)
+	if next := l.advance(s); next != 20 {
+		t.Errorf("checkpoint after pass with held match %d, want 20", next)
+	}
+	if l.spans != nil || l.held != -1 {
+		t.Errorf("pass state is kept: %v %d", l.spans, l.held)
+	}
+}
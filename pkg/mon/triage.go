@@ -0,0 +1,53 @@
+package mon
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/api"
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+// triage changes triage state of stored match |fingerprint| for API and dashboard.
+// Legitimate matches get suppression, status changes are sent to sinks.
+func (m *MonCtx) triage(fingerprint string, u *db.TriageUpdate) (*db.CertInfo, error) {
+	c, changes, err := m.db.UpdateTriage(fingerprint, u)
+	if err != nil {
+		return nil, err
+	}
+	for _, ch := range changes {
+		if ch.Field != models.TriageFieldStatus {
+			continue
+		}
+		if c.Status == models.TriageLegitimate && !c.Suppressed {
+			if err := m.suppress(c, u); err != nil {
+				log.Printf("Can't suppress legitimate match %s (%v)", fingerprint, err)
+			}
+		}
+		t := &models.TriageEvent{Match: &api.NewMatch(c).Match, Status: c.Status, Assignee: c.Assignee,
+			By: u.By, Changes: changes, Time: time.Now().UTC()}
+		log.Printf("%s (%s)", t.Title(), fingerprint)
+		m.bus.Publish(models.MonEvent{Type: models.CT_TRIAGE, Log: c.Log, LogID: c.LogID,
+			Fingerprint: fingerprint, Triage: t})
+	}
+	return c, nil
+}
+
+/* the same certificate is not notified again if seen in another log */
+func (m *MonCtx) suppress(c *db.CertInfo, u *db.TriageUpdate) error {
+	reason := u.Note
+	if reason == "" {
+		reason = "marked legitimate"
+	}
+	err := m.db.AddSuppression(&db.Suppression{Fingerprint: c.Fingerprint, Reason: fmt.Sprintf("%s (%s)", reason, u.By)})
+	if err != nil {
+		return err
+	}
+	if err := m.db.SetSuppressed(c.Fingerprint, true); err != nil {
+		return err
+	}
+	c.Suppressed = true
+	return nil
+}
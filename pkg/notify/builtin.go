@@ -0,0 +1,39 @@
+package notify
+
+import (
+	"errors"
+
+	"github.com/kyprizel/ct_mon/pkg/chat"
+	"github.com/kyprizel/ct_mon/pkg/db"
+	"github.com/kyprizel/ct_mon/pkg/jsonl"
+	"github.com/kyprizel/ct_mon/pkg/mail"
+	"github.com/kyprizel/ct_mon/pkg/syslog"
+	"github.com/kyprizel/ct_mon/pkg/webhook"
+)
+
+func init() {
+	Register("db", func(conf []byte, env *Env) (Sink, error) {
+		if env.Store == nil {
+			return nil, errors.New("no DB configured")
+		}
+		return &db.CertHandler{DB: env.Store}, nil
+	})
+	Register("mail", func(conf []byte, env *Env) (Sink, error) {
+		return mail.New(conf)
+	})
+	Register("jsonl", func(conf []byte, env *Env) (Sink, error) {
+		return jsonl.New(conf)
+	})
+	Register("webhook", func(conf []byte, env *Env) (Sink, error) {
+		return webhook.New(conf)
+	})
+	Register("syslog", func(conf []byte, env *Env) (Sink, error) {
+		return syslog.New(conf)
+	})
+	for _, flavor := range []string{chat.Slack, chat.Mattermost, chat.Teams} {
+		flavor := flavor
+		Register(flavor, func(conf []byte, env *Env) (Sink, error) {
+			return chat.New(flavor, conf)
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package notify
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/certs"
+	"github.com/kyprizel/ct_mon/pkg/db"
+	"github.com/kyprizel/ct_mon/pkg/metrics"
+)
+
+var notifications = metrics.NewCounter("ct_mon_notifications_total", "Match deliveries to sinks by result.",
+	"sink", "result")
+
+// Sink is an output receiving matches: mail, DB, JSONL etc.
+type Sink interface {
+	Notify(m *models.Match) error
+	Close() error
+}
+
+// Starter is implemented by sinks preparing before the first match, e.g. connecting.
+type Starter interface {
+	Start() error
+}
+
+// Flusher is implemented by sinks holding matches back, e.g. for digests.
+type Flusher interface {
+	Flush() error
+}
+
+// Holder is implemented by sinks holding matches back. |done| is called with the result
+// once |m| is actually sent, possibly from another goroutine.
+type Holder interface {
+	Hold(m *models.Match, done func(error))
+}
+
+// HealthNotifier is implemented by sinks reporting CT log health changes.
+type HealthNotifier interface {
+	NotifyHealth(h *models.LogHealth) error
+}
+
+// TriageNotifier is implemented by sinks reporting triage status changes of stored matches.
+type TriageNotifier interface {
+	NotifyTriage(t *models.TriageEvent) error
+}
+
+// Env holds shared resources sinks may need.
+type Env struct {
+	Store db.Store
+}
+
+// Factory creates sink from its JSON config |conf|.
+type Factory func(conf []byte, env *Env) (Sink, error)
+
+var factories = make(map[string]Factory)
+
+// Register makes sink |typ| available in config, panics if it's already registered.
+func Register(typ string, f Factory) {
+	if _, ok := factories[typ]; ok {
+		panic("notify: sink " + typ + " registered twice")
+	}
+	factories[typ] = f
+}
+
+// Filter selects matches passed to a sink, zero fields are not used.
+type Filter struct {
+	/* names of rules, any of them should be hit */
+	Rules       []string        `json:"rules"`
+	MinSeverity models.Severity `json:"min_severity"`
+	PrecertOnly bool            `json:"precert_only"`
+	/* pass suppressed matches too */
+	Suppressed bool `json:"suppressed"`
+}
+
+// Matches returns true if |m| passes the filter.
+func (f *Filter) Matches(m *models.Match) bool {
+	if m.Suppressed && !f.Suppressed {
+		return false
+	}
+	if f.PrecertOnly && !m.Precert {
+		return false
+	}
+	if m.Severity.Level() < f.MinSeverity.Level() {
+		return false
+	}
+	if len(f.Rules) == 0 {
+		return true
+	}
+	for _, want := range f.Rules {
+		for _, hit := range m.Rules {
+			if want == hit {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+const DefaultQueueSize = 1000
+
+// Handler feeds events passing |Filter| to |Sink|, |Outbox| tracks their delivery if set.
+type Handler struct {
+	Name   string
+	Type   string
+	Filter Filter
+	Sink   Sink
+	Outbox *Outbox
+	/* events queued for the sink, the scan waits for the sink on overflow unless |Drop| is set */
+	QueueSize int
+	Drop      bool
+
+	/* start of the current event handling in unix nanoseconds, 0 when idle */
+	busy int64
+	done chan struct{}
+	/* outbox notifications due for retry, sent in turn with events */
+	retries chan *db.Notification
+}
+
+// New creates handler of sink declared by |conf|: {"type": ..., filter fields, sink fields}.
+func New(conf []byte, env *Env) (*Handler, error) {
+	var c struct {
+		Name      string `json:"name"`
+		Type      string `json:"type"`
+		QueueSize int    `json:"queue_size"`
+		/* block or drop */
+		Overflow string `json:"overflow"`
+		Filter
+	}
+	if err := json.Unmarshal(conf, &c); err != nil {
+		return nil, err
+	}
+	h, err := NewWithFilter(c.Type, c.Filter, conf, env)
+	if err != nil {
+		return nil, err
+	}
+	if c.Name != "" {
+		h.Name = c.Name
+	}
+	if c.QueueSize > 0 {
+		h.QueueSize = c.QueueSize
+	}
+	switch c.Overflow {
+	case "", "block":
+	case "drop":
+		h.Drop = true
+	default:
+		return nil, fmt.Errorf("unknown overflow %q", c.Overflow)
+	}
+	return h, nil
+}
+
+// NewWithFilter creates handler of sink |typ| with |filter|.
+func NewWithFilter(typ string, filter Filter, conf []byte, env *Env) (*Handler, error) {
+	f, ok := factories[typ]
+	if !ok {
+		return nil, fmt.Errorf("unknown sink type %q", typ)
+	}
+	if filter.MinSeverity != "" && filter.MinSeverity.Level() == 0 {
+		return nil, fmt.Errorf("unknown severity %q", filter.MinSeverity)
+	}
+	sink, err := f(conf, env)
+	if err != nil {
+		return nil, fmt.Errorf("sink %s: %v", typ, err)
+	}
+	return &Handler{Name: typ, Type: typ, Filter: filter, Sink: sink, QueueSize: DefaultQueueSize,
+		done: make(chan struct{})}, nil
+}
+
+// Start prepares the sink, errors are logged only since the sink may recover later.
+func (h *Handler) Start() {
+	if s, ok := h.Sink.(Starter); ok {
+		if err := s.Start(); err != nil {
+			log.Printf("Error starting %s (%v)", h.Name, err)
+		}
+	}
+}
+
+// Handle sends match of |ev| to the sink if it passes the filter.
+func (h *Handler) Handle(ev models.MonEvent) {
+	h.HandleMatch(NewMatch(ev), ev.Outbox[h.Name])
+}
+
+// HandleMatch sends |m| to the sink if it passes the filter, outbox notification |id|
+// (may be empty) is acked once the sink has sent it.
+func (h *Handler) HandleMatch(m *models.Match, id string) {
+	if !h.Filter.Matches(m) {
+		return
+	}
+	h.deliver(m, id)
+}
+
+// HandleRetry sends outbox notification |n| again, the filter was passed before.
+func (h *Handler) HandleRetry(n *db.Notification) {
+	m := &models.Match{}
+	if err := json.Unmarshal(n.Payload, m); err != nil {
+		log.Printf("Invalid notification %s (%v)", n.Id.Hex(), err)
+		h.Outbox.Done(n.Id.Hex(), err)
+		return
+	}
+	h.deliver(m, n.Id.Hex())
+}
+
+/* queues |n| for HandleEvents, false if the queue is full */
+func (h *Handler) retry(n *db.Notification) bool {
+	select {
+	case h.retries <- n:
+		return true
+	default:
+		return false
+	}
+}
+
+func (h *Handler) deliver(m *models.Match, id string) {
+	deliver(h.Name, h.Sink, m, func(err error) {
+		if err != nil {
+			log.Printf("Error sending match to %s (%v)", h.Name, err)
+		}
+		if id != "" {
+			h.Outbox.Done(id, err)
+		}
+	})
+}
+
+// HandleHealth sends log health change to the sink if it supports them, filter is not used.
+func (h *Handler) HandleHealth(ev models.MonEvent) {
+	s, ok := h.Sink.(HealthNotifier)
+	if !ok {
+		return
+	}
+	err := s.NotifyHealth(ev.Health)
+	count(h.Name, err)
+	if err != nil {
+		log.Printf("Error sending log health to %s (%v)", h.Name, err)
+	}
+}
+
+// HandleTriage sends triage change to the sink if it supports them and the match passes the filter.
+func (h *Handler) HandleTriage(ev models.MonEvent) {
+	s, ok := h.Sink.(TriageNotifier)
+	if !ok {
+		return
+	}
+	/* legitimate matches are suppressed, the team still needs to know they are handled */
+	m := *ev.Triage.Match
+	m.Suppressed = false
+	if !h.Filter.Matches(&m) {
+		return
+	}
+	err := s.NotifyTriage(ev.Triage)
+	count(h.Name, err)
+	if err != nil {
+		log.Printf("Error sending triage change to %s (%v)", h.Name, err)
+	}
+}
+
+/* |done| gets the result once the sink has actually sent |m|, holders call it later */
+func deliver(name string, s Sink, m *models.Match, done func(error)) {
+	result := func(err error) {
+		count(name, err)
+		done(err)
+	}
+	if hs, ok := s.(Holder); ok {
+		hs.Hold(m, result)
+		return
+	}
+	result(s.Notify(m))
+}
+
+func count(name string, err error) {
+	if err != nil {
+		notifications.Inc(name, "failure")
+	} else {
+		notifications.Inc(name, "success")
+	}
+}
+
+// Close flushes held back matches and closes the sink.
+func (h *Handler) Close() {
+	if s, ok := h.Sink.(Flusher); ok {
+		if err := s.Flush(); err != nil {
+			log.Printf("Error flushing %s (%v)", h.Name, err)
+		}
+	}
+	if err := h.Sink.Close(); err != nil {
+		log.Printf("Error closing %s (%v)", h.Name, err)
+	}
+}
+
+// HandleEvents handles events from |ch| and outbox retries until CT_QUIT, all events
+// queued before it are handled.
+func (h *Handler) HandleEvents(ch chan models.MonEvent) {
+	defer close(h.done)
+	h.Start()
+	for {
+		var ev models.MonEvent
+		select {
+		case n := <-h.retries:
+			atomic.StoreInt64(&h.busy, time.Now().UnixNano())
+			h.HandleRetry(n)
+			atomic.StoreInt64(&h.busy, 0)
+			continue
+		case ev = <-ch:
+		}
+		switch ev.Type {
+		case models.CT_CERT, models.CT_PRECERT:
+			atomic.StoreInt64(&h.busy, time.Now().UnixNano())
+			h.Handle(ev)
+			atomic.StoreInt64(&h.busy, 0)
+		case models.CT_ESCALATION:
+			/* escalated matches are stored already */
+			if h.Type == "db" {
+				continue
+			}
+			atomic.StoreInt64(&h.busy, time.Now().UnixNano())
+			h.HandleMatch(ev.Match, ev.Outbox[h.Name])
+			atomic.StoreInt64(&h.busy, 0)
+		case models.CT_TRIAGE:
+			atomic.StoreInt64(&h.busy, time.Now().UnixNano())
+			h.HandleTriage(ev)
+			atomic.StoreInt64(&h.busy, 0)
+		case models.CT_LOG_HEALTH:
+			atomic.StoreInt64(&h.busy, time.Now().UnixNano())
+			h.HandleHealth(ev)
+			atomic.StoreInt64(&h.busy, 0)
+		case models.CT_QUIT:
+			h.Close()
+			return
+		}
+	}
+}
+
+// Busy returns how long the sink has been handling the current event, 0 when idle.
+func (h *Handler) Busy() time.Duration {
+	start := atomic.LoadInt64(&h.busy)
+	if start == 0 {
+		return 0
+	}
+	return time.Since(time.Unix(0, start))
+}
+
+// Wait returns when HandleEvents is done.
+func (h *Handler) Wait() {
+	<-h.done
+}
+
+// NewMatch builds normalised match record of |ev|.
+func NewMatch(ev models.MonEvent) *models.Match {
+	entry := ev.LogEntry
+	c := certs.Leaf(entry)
+	der := certs.DER(entry)
+	sum := sha256.Sum256(der)
+	return &models.Match{Log: ev.Log, LogID: ev.LogID, Index: entry.Index,
+		Timestamp: certs.Timestamp(entry), Fingerprint: ev.Fingerprint,
+		SHA256: hex.EncodeToString(sum[:]), TBSHash: ev.TBSHash,
+		Precert: ev.Type == models.CT_PRECERT, FinalForPrecert: ev.FinalForPrecert,
+		Suppressed: ev.Suppressed, Rules: ev.Rules, Severity: ev.Severity,
+		CommonName: c.Subject.CommonName, DNSNames: c.DNSNames,
+		Issuer: c.Issuer.CommonName, Serial: c.SerialNumber.String(),
+		NotBefore: c.NotBefore, NotAfter: c.NotAfter,
+		PEM:   string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		Entry: entry}
+}
@@ -0,0 +1,187 @@
+package notify
+
+import (
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+const (
+	retryBase = 30 * time.Second
+	retryMax  = time.Hour
+	dueBatch  = 100
+)
+
+// Outbox keeps matches in storage until sinks deliver them. Failed deliveries
+// are retried with exponential backoff, also after restart.
+type Outbox struct {
+	Store       db.Store
+	MaxAttempts int
+	Interval    time.Duration
+	/* delivered and failed notifications are kept this long */
+	Keep time.Duration
+
+	mu       sync.Mutex
+	handlers map[string]*Handler
+	/* handed to sinks and not acked yet, not retried meanwhile */
+	inflight map[bson.ObjectId]*db.Notification
+}
+
+func NewOutbox(store db.Store, maxAttempts int, interval time.Duration, keep time.Duration) *Outbox {
+	return &Outbox{Store: store, MaxAttempts: maxAttempts, Interval: interval, Keep: keep,
+		handlers: make(map[string]*Handler), inflight: make(map[bson.ObjectId]*db.Notification)}
+}
+
+// Register makes matches for |h| kept in the outbox, retries are sent through the handler.
+func (o *Outbox) Register(h *Handler) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	h.Outbox = o
+	h.retries = make(chan *db.Notification, dueBatch)
+	o.handlers[h.Name] = h
+}
+
+func backoff(attempts int) time.Duration {
+	d := retryBase
+	for i := 1; i < attempts && d < retryMax; i++ {
+		d *= 2
+	}
+	if d > retryMax {
+		d = retryMax
+	}
+	return d
+}
+
+// Enqueue stores |m| for every registered handler in |handlers| it passes, returns
+// notification ids by sink name to publish with the match. On error ids stored so far are returned.
+func (o *Outbox) Enqueue(handlers []*Handler, m *models.Match) (map[string]string, error) {
+	payload, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string)
+	for _, h := range handlers {
+		if h.Outbox != o || !h.Filter.Matches(m) {
+			continue
+		}
+		n := &db.Notification{Sink: h.Name, Payload: payload, NextAttempt: time.Now().UTC()}
+		if err := o.Store.EnqueueNotification(n); err != nil {
+			return ids, err
+		}
+		o.mu.Lock()
+		o.inflight[n.Id] = n
+		o.mu.Unlock()
+		ids[h.Name] = n.Id.Hex()
+	}
+	return ids, nil
+}
+
+// Release makes notification |id| due for retry, e.g. when its event was dropped.
+func (o *Outbox) Release(id string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.inflight, bson.ObjectIdHex(id))
+}
+
+// Cancel gives up notification |id| whose match was not published.
+func (o *Outbox) Cancel(id string) {
+	o.mu.Lock()
+	n := o.inflight[bson.ObjectIdHex(id)]
+	delete(o.inflight, bson.ObjectIdHex(id))
+	o.mu.Unlock()
+	if n == nil {
+		return
+	}
+	n.Status = db.OutboxFailed
+	n.LastError = "match is not published"
+	if err := o.Store.UpdateNotification(n); err != nil {
+		log.Printf("Can't update notification %s (%v)", id, err)
+	}
+}
+
+// Done records result |err| of sending notification |id|.
+func (o *Outbox) Done(id string, err error) {
+	o.mu.Lock()
+	n := o.inflight[bson.ObjectIdHex(id)]
+	delete(o.inflight, bson.ObjectIdHex(id))
+	o.mu.Unlock()
+	if n == nil {
+		return
+	}
+
+	n.Attempts++
+	if err == nil {
+		n.Status = db.OutboxDelivered
+		n.LastError = ""
+	} else {
+		n.LastError = err.Error()
+		if n.Attempts >= o.MaxAttempts {
+			n.Status = db.OutboxFailed
+			log.Printf("Giving up on notification %s for %s after %d attempts (%v)", id, n.Sink, n.Attempts, err)
+		} else {
+			n.NextAttempt = time.Now().UTC().Add(backoff(n.Attempts))
+		}
+	}
+	if uerr := o.Store.UpdateNotification(n); uerr != nil {
+		log.Printf("Can't update notification %s (%v)", id, uerr)
+	}
+}
+
+// Retry hands notifications due for retry to their handlers.
+func (o *Outbox) Retry() {
+	o.mu.Lock()
+	/* in-flight notifications may be due too, they are skipped */
+	limit := dueBatch + len(o.inflight)
+	o.mu.Unlock()
+
+	due, err := o.Store.DueNotifications(time.Now().UTC(), limit)
+	if err != nil {
+		log.Printf("Can't load outbox (%v)", err)
+		return
+	}
+	for i := range due {
+		n := &due[i]
+		o.mu.Lock()
+		h := o.handlers[n.Sink]
+		_, busy := o.inflight[n.Id]
+		if h != nil && !busy {
+			o.inflight[n.Id] = n
+		}
+		o.mu.Unlock()
+
+		switch {
+		case busy:
+		case h == nil:
+			n.Status = db.OutboxFailed
+			n.LastError = "sink is not configured"
+			if err := o.Store.UpdateNotification(n); err != nil {
+				log.Printf("Can't update notification %s (%v)", n.Id.Hex(), err)
+			}
+		case !h.retry(n):
+			/* handler queue is full, next run picks it up */
+			o.Release(n.Id.Hex())
+		}
+	}
+}
+
+// Serve retries undelivered notifications every |Interval| until |ctx| is done.
+func (o *Outbox) Serve(ctx context.Context) {
+	for {
+		o.Retry()
+		if _, err := o.Store.PurgeNotifications(time.Now().UTC().Add(-o.Keep)); err != nil {
+			log.Printf("Can't purge outbox (%v)", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(o.Interval):
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package notify
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+/* holds matches until the test sends them */
+type holdingSink struct {
+	held chan func(error)
+}
+
+func (s *holdingSink) Notify(m *models.Match) error {
+	return errors.New("not held")
+}
+
+func (s *holdingSink) Hold(m *models.Match, done func(error)) {
+	s.held <- done
+}
+
+func (s *holdingSink) Close() error {
+	return nil
+}
+
+func testOutbox(t *testing.T) (*Outbox, *Handler, *holdingSink) {
+	store, err := db.InitSQL("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink := &holdingSink{held: make(chan func(error), 10)}
+	h := &Handler{Name: "chat", Type: "slack", Sink: sink, done: make(chan struct{})}
+	o := NewOutbox(store, 3, time.Hour, time.Hour)
+	o.Register(h)
+	return o, h, sink
+}
+
+func pending(t *testing.T, o *Outbox) []db.Notification {
+	due, err := o.Store.DueNotifications(time.Now().UTC().Add(time.Hour), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return due
+}
+
+func TestOutboxHeldMatch(t *testing.T) {
+	o, h, sink := testOutbox(t)
+	defer o.Store.Close()
+
+	m := &models.Match{Fingerprint: "f1", CommonName: "www.example.com"}
+	ids, err := o.Enqueue([]*Handler{h}, m)
+	if err != nil || ids["chat"] == "" {
+		t.Fatalf("Enqueue: %v (%v)", ids, err)
+	}
+
+	/* the handler has it, retry must not send it again */
+	h.HandleMatch(m, ids["chat"])
+	o.Retry()
+	if len(h.retries) != 0 {
+		t.Fatal("held notification is retried")
+	}
+	if due := pending(t, o); len(due) != 1 || due[0].Attempts != 0 {
+		t.Fatalf("held notification is acked: %+v", due)
+	}
+
+	done := <-sink.held
+	done(errors.New("summary failed"))
+	due := pending(t, o)
+	if len(due) != 1 || due[0].Attempts != 1 || due[0].LastError != "summary failed" {
+		t.Fatalf("failed summary: %+v", due)
+	}
+
+	/* retries go through the handler */
+	n := due[0]
+	n.NextAttempt = time.Now().UTC().Add(-time.Second)
+	if err := o.Store.UpdateNotification(&n); err != nil {
+		t.Fatal(err)
+	}
+	o.Retry()
+	if len(h.retries) != 1 {
+		t.Fatalf("%d retries queued, want 1", len(h.retries))
+	}
+	ch := make(chan models.MonEvent, 1)
+	go h.HandleEvents(ch)
+	done = <-sink.held
+	done(nil)
+	ch <- models.MonEvent{Type: models.CT_QUIT}
+	h.Wait()
+
+	if due := pending(t, o); len(due) != 0 {
+		t.Errorf("delivered notification is pending: %+v", due)
+	}
+}
+
+func TestOutboxRelease(t *testing.T) {
+	o, h, _ := testOutbox(t)
+	defer o.Store.Close()
+
+	ids, err := o.Enqueue([]*Handler{h}, &models.Match{Fingerprint: "f1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.Retry()
+	if len(h.retries) != 0 {
+		t.Fatal("published notification is retried")
+	}
+	/* event was dropped by the bus */
+	o.Release(ids["chat"])
+	o.Retry()
+	if len(h.retries) != 1 {
+		t.Fatalf("%d retries queued, want 1", len(h.retries))
+	}
+}
+
+func TestOutboxFilter(t *testing.T) {
+	o, h, _ := testOutbox(t)
+	defer o.Store.Close()
+	h.Filter = Filter{MinSeverity: models.SeverityHigh}
+	other := &Handler{Name: "jsonl", Sink: &holdingSink{}}
+
+	ids, err := o.Enqueue([]*Handler{h, other}, &models.Match{Fingerprint: "f1", Severity: models.SeverityLow})
+	if err != nil || len(ids) != 0 {
+		t.Errorf("filtered match enqueued: %v (%v)", ids, err)
+	}
+	ids, err = o.Enqueue([]*Handler{h, other}, &models.Match{Fingerprint: "f2", Severity: models.SeverityHigh})
+	if err != nil || len(ids) != 1 || ids["chat"] == "" {
+		t.Errorf("Enqueue: %v (%v)", ids, err)
+	}
+}
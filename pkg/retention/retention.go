@@ -0,0 +1,167 @@
+package retention
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/kyprizel/ct_mon/pkg/db"
+	"github.com/kyprizel/ct_mon/pkg/metrics"
+)
+
+const batchSize = 1000
+
+var (
+	runs     = metrics.NewCounter("ct_mon_retention_runs_total", "Retention runs.")
+	deleted  = metrics.NewCounter("ct_mon_retention_deleted_total", "Matches removed by retention.")
+	archived = metrics.NewCounter("ct_mon_retention_archived_total", "Matches archived by retention.")
+	failures = metrics.NewCounter("ct_mon_retention_errors_total", "Failed retention runs.")
+	purged   = metrics.NewCounter("ct_mon_retention_purged_total", "Sightings and precerts removed by retention.", "kind")
+)
+
+// Policy removes stored matches, optionally archiving them to gzip JSONL files first.
+type Policy struct {
+	Store db.Store
+	/* matches of certificates expired longer than this ago are removed, 0 keeps them */
+	Expired time.Duration
+	/* suppressed matches stored longer than this ago are removed earlier, 0 keeps them until expired */
+	Suppressed time.Duration
+	ArchiveDir string
+	Interval   time.Duration
+	Verbose    bool
+}
+
+// Serve runs retention every |Interval| until |ctx| is done.
+func (p *Policy) Serve(ctx context.Context) {
+	for {
+		if err := p.Run(); err != nil {
+			failures.Inc()
+			log.Printf("Retention failed (%v)", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.Interval):
+		}
+	}
+}
+
+// Run removes all matches due for deletion once.
+func (p *Policy) Run() error {
+	runs.Inc()
+	now := time.Now().UTC()
+	suppressed := true
+
+	var total int
+	if p.Expired > 0 {
+		n, err := p.purge("expired", db.CertQuery{NotAfterBefore: now.Add(-p.Expired)})
+		total += n
+		if err != nil {
+			return err
+		}
+	}
+	if p.Suppressed > 0 {
+		n, err := p.purge("suppressed", db.CertQuery{CreatedBefore: now.Add(-p.Suppressed), Suppressed: &suppressed})
+		total += n
+		if err != nil {
+			return err
+		}
+	}
+	if total > 0 || p.Verbose {
+		log.Printf("Retention removed %d matches", total)
+	}
+	if p.Expired > 0 {
+		return p.purgeSeen(now.Add(-p.Expired))
+	}
+	return nil
+}
+
+/* dedup and correlation state of certificates not seen since |before| */
+func (p *Policy) purgeSeen(before time.Time) error {
+	sightings, err := p.Store.PurgeSightings(before)
+	if err != nil {
+		return err
+	}
+	purged.Add(float64(sightings), "sightings")
+	precerts, err := p.Store.PurgePrecerts(before)
+	if err != nil {
+		return err
+	}
+	purged.Add(float64(precerts), "precerts")
+	if sightings+precerts > 0 || p.Verbose {
+		log.Printf("Retention removed sightings of %d certificates and %d precerts", sightings, precerts)
+	}
+	return nil
+}
+
+func (p *Policy) purge(kind string, q db.CertQuery) (int, error) {
+	q.Limit = batchSize
+	total := 0
+	for batch := 0; ; batch++ {
+		certs, err := p.Store.FindCerts(q)
+		if err != nil {
+			return total, err
+		}
+		if len(certs) == 0 {
+			return total, nil
+		}
+
+		if p.ArchiveDir != "" {
+			if err := p.archive(certs, kind, batch); err != nil {
+				return total, err
+			}
+			archived.Add(float64(len(certs)))
+		}
+
+		fingerprints := make([]string, 0, len(certs))
+		for _, c := range certs {
+			fingerprints = append(fingerprints, c.Fingerprint)
+		}
+		n, err := p.Store.DeleteCerts(fingerprints)
+		deleted.Add(float64(n))
+		total += n
+		if err != nil || n == 0 {
+			return total, err
+		}
+	}
+}
+
+/* matches are deleted only after archive file is fully on disk, existing archives are never replaced */
+func (p *Policy) archive(certs []db.CertInfo, kind string, batch int) error {
+	name := fmt.Sprintf("matches-%s-%s-%d.jsonl.gz", kind,
+		time.Now().UTC().Format("20060102T150405.000000000"), batch)
+	tmp, err := ioutil.TempFile(p.ArchiveDir, name+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	gz := gzip.NewWriter(tmp)
+	enc := json.NewEncoder(gz)
+	for i := range certs {
+		if err := enc.Encode(&certs[i]); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	if err := gz.Close(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	/* unlike rename, link fails if the name is taken */
+	return os.Link(tmp.Name(), filepath.Join(p.ArchiveDir, name))
+}
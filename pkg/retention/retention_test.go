@@ -0,0 +1,180 @@
+package retention
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+func testStore(t *testing.T) db.Store {
+	s, err := db.InitSQL("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func store(t *testing.T, s db.Store, fingerprint string, notAfter time.Time, suppressed bool) {
+	c := &db.CertInfo{Fingerprint: fingerprint, CommonName: fingerprint + ".example.com", NotAfter: notAfter,
+		Suppressed: suppressed}
+	if err := s.StoreCertDetails(c); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func remaining(t *testing.T, s db.Store) []string {
+	certs, err := s.FindCerts(db.CertQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result []string
+	for _, c := range certs {
+		result = append(result, c.Fingerprint)
+	}
+	sort.Strings(result)
+	return result
+}
+
+/* fingerprints of all archived matches by archive kind */
+func readArchives(t *testing.T, dir string) map[string][]string {
+	files, err := filepath.Glob(filepath.Join(dir, "matches-*.jsonl.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := make(map[string][]string)
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dec := json.NewDecoder(gz)
+		for dec.More() {
+			var c db.CertInfo
+			if err := dec.Decode(&c); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			kind := filepath.Base(name)[len("matches-"):]
+			kind = kind[:len(kind)-len("-20060102T150405.000000000-0.jsonl.gz")]
+			result[kind] = append(result[kind], c.Fingerprint)
+		}
+		f.Close()
+	}
+	for _, list := range result {
+		sort.Strings(list)
+	}
+	return result
+}
+
+func TestExpiredIncludesSuppressed(t *testing.T) {
+	s := testStore(t)
+	defer s.Close()
+	now := time.Now().UTC()
+	store(t, s, "expired", now.Add(-48*time.Hour), false)
+	store(t, s, "expired-suppressed", now.Add(-48*time.Hour), true)
+	store(t, s, "valid-suppressed", now.Add(48*time.Hour), true)
+
+	p := &Policy{Store: s, Expired: 24 * time.Hour}
+	if err := p.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if got := remaining(t, s); len(got) != 1 || got[0] != "valid-suppressed" {
+		t.Errorf("remaining %v, want [valid-suppressed]", got)
+	}
+}
+
+func TestArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ct_mon_retention")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := testStore(t)
+	defer s.Close()
+	now := time.Now().UTC()
+	store(t, s, "expired", now.Add(-48*time.Hour), false)
+	store(t, s, "suppressed", now.Add(48*time.Hour), true)
+	store(t, s, "valid", now.Add(48*time.Hour), false)
+
+	/* suppressed matches are at least a nanosecond old by the time of the run */
+	p := &Policy{Store: s, Expired: 24 * time.Hour, Suppressed: time.Nanosecond, ArchiveDir: dir}
+	if err := p.Run(); err != nil {
+		t.Fatal(err)
+	}
+	/* the next run must not touch earlier archives */
+	store(t, s, "expired-later", now.Add(-48*time.Hour), false)
+	if err := p.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := remaining(t, s); len(got) != 1 || got[0] != "valid" {
+		t.Errorf("remaining %v, want [valid]", got)
+	}
+	archives := readArchives(t, dir)
+	if got := archives["expired"]; len(got) != 2 || got[0] != "expired" || got[1] != "expired-later" {
+		t.Errorf("expired archives %v", got)
+	}
+	if got := archives["suppressed"]; len(got) != 1 || got[0] != "suppressed" {
+		t.Errorf("suppressed archives %v", got)
+	}
+	if tmp, _ := filepath.Glob(filepath.Join(dir, "*.tmp*")); len(tmp) != 0 {
+		t.Errorf("temporary files left: %v", tmp)
+	}
+}
+
+func TestPurgeSeen(t *testing.T) {
+	s := testStore(t)
+	defer s.Close()
+	sighting := db.Sighting{Log: "https://log.example.com/", Index: 1, Timestamp: time.Now().UTC()}
+	if _, err := s.AddSighting("old", false, sighting); err != nil {
+		t.Fatal(err)
+	}
+	for _, hash := range []string{"linked", "unlinked"} {
+		if err := s.StorePrecert(&db.PrecertInfo{TBSHash: hash, Log: sighting.Log}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.LinkPrecert("linked", sighting.Log, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Policy{Store: s, Expired: 24 * time.Hour}
+	if err := p.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.FindSightings("old"); err != nil {
+		t.Errorf("sightings of recent certificate removed (%v)", err)
+	}
+
+	/* everything is older than the cutoff */
+	if err := p.purgeSeen(time.Now().UTC().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.FindSightings("old"); err != db.ErrNotFound {
+		t.Errorf("sightings are kept (%v)", err)
+	}
+	if _, err := s.FindFingerprint(sighting.Log, sighting.Index); err != db.ErrNotFound {
+		t.Errorf("sighting index is kept (%v)", err)
+	}
+	if unlinked, err := s.UnlinkedPrecerts(time.Now().UTC().Add(time.Minute)); err != nil || len(unlinked) != 0 {
+		t.Errorf("unlinked precerts are kept: %+v (%v)", unlinked, err)
+	}
+	/* seen again, it's a new precert unless the linked one is kept */
+	if err := s.StorePrecert(&db.PrecertInfo{TBSHash: "linked", Log: sighting.Log}); err != nil {
+		t.Fatal(err)
+	}
+	if linked, err := s.LinkPrecert("linked", sighting.Log, 3); err != nil || !linked {
+		t.Errorf("linked precert is kept (%v)", err)
+	}
+}
@@ -0,0 +1,185 @@
+package syslog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+const (
+	vendor  = "ct_mon"
+	product = "ct_mon"
+	version = "1.0"
+)
+
+/* CEF and LEEF use 0-10 severity */
+var eventSeverity = map[models.Severity]int{
+	models.SeverityLow:      3,
+	models.SeverityMedium:   5,
+	models.SeverityHigh:     8,
+	models.SeverityCritical: 10,
+}
+
+func eventID(m *models.Match) (string, string) {
+	if m.Escalated {
+		return "escalated", "Certificate escalated"
+	}
+	if m.Precert {
+		return "precert", "New precertificate found"
+	}
+	return "cert", "New certificate found"
+}
+
+func millis(m *models.Match) string {
+	return strconv.FormatInt(m.Timestamp.UnixNano()/1e6, 10)
+}
+
+var cefHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+var cefValueEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+
+/* CEF extension is space separated key=value pairs, values are escaped */
+func cefExt(ext [][2]string) string {
+	var b strings.Builder
+	for i, kv := range ext {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(kv[0])
+		b.WriteByte('=')
+		b.WriteString(cefValueEscaper.Replace(kv[1]))
+	}
+	return b.String()
+}
+
+// CEF formats |m| as ArcSight Common Event Format record.
+func CEF(m *models.Match) string {
+	id, name := eventID(m)
+	ext := [][2]string{
+		{"rt", millis(m)},
+		{"dhost", m.CommonName},
+		{"start", strconv.FormatInt(m.NotBefore.UnixNano()/1e6, 10)},
+		{"end", strconv.FormatInt(m.NotAfter.UnixNano()/1e6, 10)},
+		{"fileHash", m.SHA256},
+		{"request", "https://crt.sh/?sha256=" + m.SHA256},
+		{"cs1Label", "rules"}, {"cs1", strings.Join(m.Rules, ",")},
+		{"cs2Label", "dnsNames"}, {"cs2", strings.Join(m.DNSNames, ",")},
+		{"cs3Label", "issuer"}, {"cs3", m.Issuer},
+		{"cs4Label", "fingerprint"}, {"cs4", m.Fingerprint},
+		{"cs5Label", "log"}, {"cs5", m.Log},
+		{"cs6Label", "serial"}, {"cs6", m.Serial},
+		{"cn1Label", "index"}, {"cn1", strconv.FormatInt(m.Index, 10)},
+	}
+	return fmt.Sprintf("CEF:0|%s|%s|%s|%s|%s|%d|", vendor, product, version,
+		cefHeaderEscaper.Replace(id), cefHeaderEscaper.Replace(name), eventSeverity[m.Severity]) + cefExt(ext)
+}
+
+func healthID(h *models.LogHealth) string {
+	if h.Resolved {
+		return "log_recovered"
+	}
+	return "log_problem"
+}
+
+// HealthCEF formats log health change |h| as CEF record.
+func HealthCEF(h *models.LogHealth) string {
+	ext := [][2]string{
+		{"rt", strconv.FormatInt(h.Time.UnixNano()/1e6, 10)},
+		{"start", strconv.FormatInt(h.Since.UnixNano()/1e6, 10)},
+		{"msg", h.Message},
+		{"cs1Label", "problem"}, {"cs1", h.Problem},
+		{"cs5Label", "log"}, {"cs5", h.Log},
+	}
+	return fmt.Sprintf("CEF:0|%s|%s|%s|%s|%s|%d|", vendor, product, version,
+		healthID(h), cefHeaderEscaper.Replace(h.Title()), eventSeverity[h.Severity]) + cefExt(ext)
+}
+
+var leefEscaper = strings.NewReplacer("\t", " ", "\n", " ", "\r", " ")
+
+/* LEEF 1.0 attributes are tab separated key=value pairs, values can't have tabs and newlines */
+func leefAttrs(attrs [][2]string) string {
+	var b strings.Builder
+	for i, kv := range attrs {
+		if i > 0 {
+			b.WriteByte('\t')
+		}
+		b.WriteString(kv[0])
+		b.WriteByte('=')
+		b.WriteString(leefEscaper.Replace(kv[1]))
+	}
+	return b.String()
+}
+
+// LEEF formats |m| as IBM QRadar LEEF 1.0 record with tab separated attributes.
+func LEEF(m *models.Match) string {
+	id, name := eventID(m)
+	attrs := [][2]string{
+		{"devTime", millis(m)},
+		{"sev", strconv.Itoa(eventSeverity[m.Severity])},
+		{"cat", name},
+		{"dstHost", m.CommonName},
+		{"dnsNames", strings.Join(m.DNSNames, ",")},
+		{"issuer", m.Issuer},
+		{"rules", strings.Join(m.Rules, ",")},
+		{"fingerprint", m.Fingerprint},
+		{"sha256", m.SHA256},
+		{"serial", m.Serial},
+		{"notBefore", m.NotBefore.Format("2006-01-02T15:04:05Z")},
+		{"notAfter", m.NotAfter.Format("2006-01-02T15:04:05Z")},
+		{"log", m.Log},
+		{"index", strconv.FormatInt(m.Index, 10)},
+		{"url", "https://crt.sh/?sha256=" + m.SHA256},
+	}
+	return fmt.Sprintf("LEEF:1.0|%s|%s|%s|%s|", vendor, product, version, id) + leefAttrs(attrs)
+}
+
+// HealthLEEF formats log health change |h| as LEEF 1.0 record.
+func HealthLEEF(h *models.LogHealth) string {
+	attrs := [][2]string{
+		{"devTime", strconv.FormatInt(h.Time.UnixNano()/1e6, 10)},
+		{"sev", strconv.Itoa(eventSeverity[h.Severity])},
+		{"cat", h.Title()},
+		{"log", h.Log},
+		{"problem", h.Problem},
+		{"since", h.Since.UTC().Format("2006-01-02T15:04:05Z")},
+		{"msg", h.Message},
+	}
+	return fmt.Sprintf("LEEF:1.0|%s|%s|%s|%s|", vendor, product, version, healthID(h)) + leefAttrs(attrs)
+}
+
+// TriageCEF formats triage status change |t| as CEF record.
+func TriageCEF(t *models.TriageEvent) string {
+	m := t.Match
+	ext := [][2]string{
+		{"rt", strconv.FormatInt(t.Time.UnixNano()/1e6, 10)},
+		{"dhost", m.CommonName},
+		{"fileHash", m.SHA256},
+		{"suser", t.By},
+		{"msg", t.Note()},
+		{"outcome", string(t.Status)},
+		{"cs1Label", "rules"}, {"cs1", strings.Join(m.Rules, ",")},
+		{"cs2Label", "assignee"}, {"cs2", t.Assignee},
+		{"cs4Label", "fingerprint"}, {"cs4", m.Fingerprint},
+	}
+	return fmt.Sprintf("CEF:0|%s|%s|%s|triage|%s|%d|", vendor, product, version,
+		cefHeaderEscaper.Replace(t.Title()), eventSeverity[m.Severity]) + cefExt(ext)
+}
+
+// TriageLEEF formats triage status change |t| as LEEF 1.0 record.
+func TriageLEEF(t *models.TriageEvent) string {
+	m := t.Match
+	attrs := [][2]string{
+		{"devTime", strconv.FormatInt(t.Time.UnixNano()/1e6, 10)},
+		{"sev", strconv.Itoa(eventSeverity[m.Severity])},
+		{"cat", t.Title()},
+		{"dstHost", m.CommonName},
+		{"status", string(t.Status)},
+		{"assignee", t.Assignee},
+		{"usrName", t.By},
+		{"note", t.Note()},
+		{"fingerprint", m.Fingerprint},
+		{"sha256", m.SHA256},
+	}
+	return fmt.Sprintf("LEEF:1.0|%s|%s|%s|triage|", vendor, product, version) + leefAttrs(attrs)
+}
@@ -0,0 +1,75 @@
+package syslog
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+func TestCEFExtEscaping(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{`plain`, `plain`},
+		{`back\slash`, `back\\slash`},
+		{`a=b`, `a\=b`},
+		/* pipes are escaped in the header only */
+		{`a|b`, `a|b`},
+		{"two\nlines", `two\nlines`},
+		{"crlf\r\n", `crlf\r\n`},
+		{`\=`, `\\\=`},
+	}
+	for _, tt := range tests {
+		if got := cefExt([][2]string{{"msg", tt.value}}); got != "msg="+tt.want {
+			t.Errorf("cefExt(%q) = %q, want %q", tt.value, got, "msg="+tt.want)
+		}
+	}
+	if got := cefExt([][2]string{{"a", "1"}, {"b", "x y"}}); got != "a=1 b=x y" {
+		t.Errorf("cefExt pairs = %q", got)
+	}
+}
+
+func TestCEFHeaderEscaping(t *testing.T) {
+	tr := &models.TriageEvent{Match: &models.Match{CommonName: `a|b\c` + "\nd", Severity: models.SeverityHigh},
+		Status: models.TriageMalicious, By: "x=y"}
+	got := TriageCEF(tr)
+	want := `CEF:0|ct_mon|ct_mon|1.0|triage|Match a\|b\\c d is malicious by x=y|8|`
+	if !strings.HasPrefix(got, want) {
+		t.Errorf("TriageCEF header\n%s\nwant prefix\n%s", got, want)
+	}
+	if !strings.Contains(got, ` suser=x\=y `) {
+		t.Errorf("TriageCEF extension is not escaped: %s", got)
+	}
+}
+
+func TestLEEFAttrs(t *testing.T) {
+	got := leefAttrs([][2]string{{"a", "tab\there"}, {"b", "new\r\nline"}, {"c", "x=y|z"}})
+	if want := "a=tab here\tb=new  line\tc=x=y|z"; got != want {
+		t.Errorf("leefAttrs = %q, want %q", got, want)
+	}
+}
+
+func TestCEF(t *testing.T) {
+	m := &models.Match{Timestamp: time.Unix(1456790400, 0), CommonName: "login.example.com",
+		DNSNames: []string{"login.example.com", "www.example.com"}, Rules: []string{"phishing"},
+		Severity: models.SeverityCritical, Precert: true, Index: 7, Issuer: "Test CA", Log: "https://ct.example.com/"}
+	got := CEF(m)
+	for _, want := range []string{
+		"CEF:0|ct_mon|ct_mon|1.0|precert|New precertificate found|10|rt=1456790400000 dhost=login.example.com ",
+		" cs2Label=dnsNames cs2=login.example.com,www.example.com ",
+		" cs5Label=log cs5=https://ct.example.com/ ",
+		" cn1Label=index cn1=7",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("CEF record\n%s\nhas no %q", got, want)
+		}
+	}
+
+	leef := LEEF(m)
+	if !strings.HasPrefix(leef, "LEEF:1.0|ct_mon|ct_mon|1.0|precert|devTime=1456790400000\tsev=10\t") {
+		t.Errorf("LEEF record %q", leef)
+	}
+}
@@ -0,0 +1,209 @@
+package syslog
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+var facilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+	"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19,
+	"local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+/* syslog severity of match: crit, err, warning, notice */
+var syslogSeverity = map[models.Severity]int{
+	models.SeverityLow:      5,
+	models.SeverityMedium:   4,
+	models.SeverityHigh:     3,
+	models.SeverityCritical: 2,
+}
+
+// CertHandler sends matches as RFC 5424 syslog messages with CEF or LEEF payload.
+type CertHandler struct {
+	/* udp, tcp, tls, unix or unixgram */
+	Network  string `json:"network"`
+	Address  string `json:"address"`
+	Format   string `json:"format"`
+	Facility string `json:"facility"`
+	AppName  string `json:"app_name"`
+	CAFile   string `json:"ca_file"`
+
+	facility     int
+	format       func(m *models.Match) string
+	healthFormat func(h *models.LogHealth) string
+	triageFormat func(t *models.TriageEvent) string
+	hostname     string
+	tls          *tls.Config
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// New creates handler from |conf|, local /dev/log is used by default.
+func New(conf []byte) (*CertHandler, error) {
+	s := &CertHandler{Network: "unixgram", Address: "/dev/log", Format: "cef",
+		Facility: "local0", AppName: "ct_mon"}
+	if err := json.Unmarshal(conf, s); err != nil {
+		return nil, err
+	}
+
+	switch s.Format {
+	case "cef":
+		s.format, s.healthFormat, s.triageFormat = CEF, HealthCEF, TriageCEF
+	case "leef":
+		s.format, s.healthFormat, s.triageFormat = LEEF, HealthLEEF, TriageLEEF
+	default:
+		return nil, fmt.Errorf("unknown format %q", s.Format)
+	}
+
+	facility, ok := facilities[s.Facility]
+	if !ok {
+		return nil, fmt.Errorf("unknown facility %q", s.Facility)
+	}
+	s.facility = facility
+
+	switch s.Network {
+	case "udp", "tcp", "unix", "unixgram":
+	case "tls":
+		host, _, err := net.SplitHostPort(s.Address)
+		if err != nil {
+			return nil, err
+		}
+		s.tls = &tls.Config{ServerName: host}
+		if s.CAFile != "" {
+			pem, err := ioutil.ReadFile(s.CAFile)
+			if err != nil {
+				return nil, err
+			}
+			s.tls.RootCAs = x509.NewCertPool()
+			if !s.tls.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, errors.New("no certificates in " + s.CAFile)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown network %q", s.Network)
+	}
+
+	s.hostname, _ = os.Hostname()
+	if s.hostname == "" {
+		s.hostname = "-"
+	}
+	return s, nil
+}
+
+func (s *CertHandler) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	if s.tls != nil {
+		conn, err := tls.DialWithDialer(dialer, "tcp", s.Address, s.tls)
+		if err != nil {
+			return nil, err
+		}
+		return conn, nil
+	}
+	return dialer.Dial(s.Network, s.Address)
+}
+
+// Message returns RFC 5424 message for |m|.
+func (s *CertHandler) Message(m *models.Match) string {
+	return s.message(m.Severity, "match", s.format(m))
+}
+
+// HealthMessage returns RFC 5424 message for log health change |h|.
+func (s *CertHandler) HealthMessage(h *models.LogHealth) string {
+	return s.message(h.Severity, "loghealth", s.healthFormat(h))
+}
+
+// TriageMessage returns RFC 5424 message for triage status change |t|.
+func (s *CertHandler) TriageMessage(t *models.TriageEvent) string {
+	return s.message(t.Match.Severity, "triage", s.triageFormat(t))
+}
+
+func (s *CertHandler) message(sev models.Severity, msgID string, payload string) string {
+	severity, ok := syslogSeverity[sev]
+	if !ok {
+		severity = 4
+	}
+	return fmt.Sprintf("<%d>1 %s %s %s %d %s - %s", s.facility*8+severity,
+		time.Now().UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		s.hostname, s.AppName, os.Getpid(), msgID, payload)
+}
+
+/* stream transports use octet counting framing of RFC 6587 */
+func (s *CertHandler) frame(msg string) []byte {
+	if s.Network == "tcp" || s.Network == "tls" || s.Network == "unix" {
+		return []byte(fmt.Sprintf("%d %s", len(msg), msg))
+	}
+	return []byte(msg)
+}
+
+// Start connects to receiver, so misconfiguration is seen on startup.
+func (s *CertHandler) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn != nil {
+		return nil
+	}
+	var err error
+	s.conn, err = s.dial()
+	return err
+}
+
+func (s *CertHandler) Notify(m *models.Match) error {
+	return s.write(s.frame(s.Message(m)))
+}
+
+func (s *CertHandler) NotifyHealth(h *models.LogHealth) error {
+	return s.write(s.frame(s.HealthMessage(h)))
+}
+
+func (s *CertHandler) NotifyTriage(t *models.TriageEvent) error {
+	return s.write(s.frame(s.TriageMessage(t)))
+}
+
+func (s *CertHandler) write(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	/* reconnect once if receiver closed connection */
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if s.conn == nil {
+			s.conn, err = s.dial()
+			if err != nil {
+				return err
+			}
+		}
+		s.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+		if _, err = s.conn.Write(data); err == nil {
+			return nil
+		}
+		s.conn.Close()
+		s.conn = nil
+	}
+	return err
+}
+
+func (s *CertHandler) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
@@ -0,0 +1,170 @@
+package web
+
+import (
+	"html/template"
+	"strings"
+	"time"
+)
+
+/* kept in the binary, the dashboard needs no files next to it */
+const layout = `{{ define "header" }}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="UTF-8">
+<title>ct_mon</title>
+<style>
+body { font: 14px sans-serif; margin: 1em 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { text-align: left; padding: 3px 8px; border-bottom: 1px solid #ddd; vertical-align: top; }
+pre { font-size: 12px; background: #f6f6f6; padding: 8px; }
+form { display: inline-block; margin-right: 1em; }
+.low { color: #666; } .medium { color: #b80; } .high { color: #d50; } .critical { color: #c00; font-weight: bold; }
+.problem { color: #c00; } .ok { color: #080; } .muted { color: #888; }
+.done { background: #efe; padding: 6px; }
+</style>
+</head>
+<body>
+<p><a href="/ui/">ct_mon</a> <span class="muted">{{ or .User "read-only" }}</span></p>
+{{ end }}
+
+{{ define "footer" }}</body>
+</html>
+{{ end }}
+
+{{ define "index" }}{{ template "header" . }}
+<h2>Logs</h2>
+<table>
+<tr><th>Log</th><th>Index</th><th>Tree size</th><th>Behind</th><th>STH fetched</th><th>Health</th></tr>
+{{ range .Logs }}<tr>
+<td>{{ .Uri }}</td><td>{{ .Index }}</td><td>{{ .TreeSize }}</td><td>{{ .Lag }}</td>
+<td>{{ if .Fetched }}{{ duration .STHAge }} ago{{ else }}never{{ end }}</td>
+<td>{{ range .Problems }}<div class="problem">{{ . }}</div>{{ else }}<span class="ok">ok</span>{{ end }}</td>
+</tr>{{ end }}
+</table>
+
+<h2>Matches</h2>
+<form method="GET" action="/ui/">
+<input name="name" placeholder="name or *.example.com" value="{{ .Query.Get "name" }}">
+<input name="rule" placeholder="rule" value="{{ .Query.Get "rule" }}">
+<input name="assignee" placeholder="assignee" value="{{ .Query.Get "assignee" }}">
+<select name="status">
+<option value="">any status</option>
+{{ $status := .Query.Get "status" }}{{ range .Statuses }}<option{{ if eq (print .) $status }} selected{{ end }}>{{ . }}</option>
+{{ end }}</select>
+<select name="suppressed">
+<option value="">all</option>
+<option value="false"{{ if eq (.Query.Get "suppressed") "false" }} selected{{ end }}>not suppressed</option>
+<option value="true"{{ if eq (.Query.Get "suppressed") "true" }} selected{{ end }}>suppressed</option>
+</select>
+<input type="submit" value="Search">
+</form>
+{{ if .Error }}<p class="problem">{{ .Error }}</p>{{ end }}
+<table>
+<tr><th>Stored</th><th>Name</th><th>Issuer</th><th>Rules</th><th>Severity</th><th>Status</th><th>Assignee</th></tr>
+{{ range .Matches }}<tr>
+<td>{{ datetime .Created }}</td>
+<td><a href="/ui/matches/{{ .Fingerprint }}">{{ .CommonName }}</a>{{ if .Precert }} <span class="muted">precert</span>{{ end }}</td>
+<td>{{ .Issuer }}</td>
+<td>{{ join .Rules ", " }}</td>
+<td class="{{ .Severity }}">{{ .Severity }}</td>
+<td>{{ .Status }}{{ if .Suppressed }} <span class="muted">suppressed</span>{{ end }}</td>
+<td>{{ .Assignee }}</td>
+</tr>{{ else }}<tr><td colspan="7" class="muted">No matches</td></tr>{{ end }}
+</table>
+<p>{{ if .Newer }}<a href="{{ .Newer }}">&larr; newer</a> {{ end }}{{ if .Older }}<a href="{{ .Older }}">older &rarr;</a>{{ end }}</p>
+{{ template "footer" . }}{{ end }}
+
+{{ define "match" }}{{ template "header" . }}
+{{ with .Match }}<h2>{{ .CommonName }}{{ if .Precert }} (precertificate){{ end }}</h2>{{ end }}
+{{ if .Done }}<p class="done">Done: {{ .Done }}</p>{{ end }}
+
+{{ if .ReadOnly }}<p class="muted">Read-only, set api_token to change triage and escalate</p>{{ else }}
+<p>
+<form method="POST" action="/ui/matches/{{ .Match.Fingerprint }}/acknowledge">
+<input type="hidden" name="csrf" value="{{ .CSRF }}">
+<input type="submit" value="Acknowledge">
+</form>
+<form method="POST" action="/ui/matches/{{ .Match.Fingerprint }}/legitimate">
+<input type="hidden" name="csrf" value="{{ .CSRF }}">
+<input name="note" placeholder="reason">
+<input type="submit" value="Mark legitimate"{{ if eq (print .Match.Status) "legitimate" }} disabled{{ end }}>
+</form>
+<form method="POST" action="/ui/matches/{{ .Match.Fingerprint }}/escalate">
+<input type="hidden" name="csrf" value="{{ .CSRF }}">
+<input type="submit" value="Escalate">
+</form>
+</p>
+<p>
+<form method="POST" action="/ui/matches/{{ .Match.Fingerprint }}/triage">
+<input type="hidden" name="csrf" value="{{ .CSRF }}">
+<select name="status">
+{{ $status := .Match.Status }}{{ range .Statuses }}<option{{ if eq . $status }} selected{{ end }}>{{ . }}</option>
+{{ end }}</select>
+<input name="assignee" placeholder="assignee" value="{{ .Match.Assignee }}">
+<input name="note" placeholder="note" size="50">
+<input type="submit" value="Update">
+</form>
+</p>
+{{ end }}
+
+<table>
+{{ with .Cert }}<tr><th>Status</th><td>{{ or .Status "new" }}{{ if .Suppressed }} <span class="muted">suppressed</span>{{ end }}</td></tr>
+{{ if .Assignee }}<tr><th>Assignee</th><td>{{ .Assignee }}</td></tr>{{ end }}
+<tr><th>Rules</th><td>{{ join .Rules ", " }} <span class="{{ .Severity }}">{{ .Severity }}</span></td></tr>
+<tr><th>Stored</th><td>{{ datetime .Created }}</td></tr>
+<tr><th>Common name</th><td>{{ .CommonName }}</td></tr>
+<tr><th>DNS names</th><td>{{ range .DNSNames }}{{ . }}<br>{{ end }}</td></tr>
+{{ if .EmailAddresses }}<tr><th>Emails</th><td>{{ join .EmailAddresses ", " }}</td></tr>{{ end }}
+<tr><th>Issuer</th><td>{{ .Issuer }}</td></tr>
+<tr><th>Serial</th><td>{{ .Serial }}</td></tr>
+<tr><th>Valid</th><td>{{ datetime .NotBefore }} - {{ datetime .NotAfter }}</td></tr>
+{{ if .OCSPServer }}<tr><th>OCSP</th><td>{{ join .OCSPServer ", " }}</td></tr>{{ end }}
+{{ if .IssuingCertificateURL }}<tr><th>Issuer URL</th><td>{{ join .IssuingCertificateURL ", " }}</td></tr>{{ end }}
+<tr><th>Fingerprint</th><td>{{ .Fingerprint }}</td></tr>
+{{ if .TBSHash }}<tr><th>TBS hash</th><td>{{ .TBSHash }}{{ if .PrecertSeen }} <span class="muted">final certificate of reported precert</span>{{ end }}</td></tr>{{ end }}
+{{ end }}
+{{ with .Parsed }}
+<tr><th>Subject</th><td>{{ .Subject.CommonName }}{{ range .Subject.Organization }}, O={{ . }}{{ end }}{{ range .Subject.Country }}, C={{ . }}{{ end }}</td></tr>
+<tr><th>Issuer organization</th><td>{{ join .Issuer.Organization ", " }}</td></tr>
+{{ if .IPAddresses }}<tr><th>IP addresses</th><td>{{ range .IPAddresses }}{{ . }}<br>{{ end }}</td></tr>{{ end }}
+{{ if .CRLDistributionPoints }}<tr><th>CRL</th><td>{{ join .CRLDistributionPoints ", " }}</td></tr>{{ end }}
+<tr><th>CA</th><td>{{ .IsCA }}</td></tr>
+{{ end }}
+<tr><th>SHA256</th><td>{{ .Match.SHA256 }}</td></tr>
+<tr><th>Details</th><td><a href="https://crt.sh/?sha256={{ .Match.SHA256 }}">crt.sh</a></td></tr>
+</table>
+
+{{ if .Cert.Lints }}<h3>Lints</h3>
+<table>{{ range .Cert.Lints }}<tr><td>{{ .Level }}</td><td>{{ .Name }}</td><td>{{ .Details }}</td></tr>{{ end }}</table>{{ end }}
+
+{{ if .Cert.History }}<h3>History</h3>
+<table>
+<tr><th>Time</th><th>By</th><th>Change</th></tr>
+{{ range .Cert.History }}<tr><td>{{ datetime .Time }}</td><td>{{ .By }}</td>
+<td>{{ if eq .Field "note" }}{{ .To }}{{ else }}{{ .Field }}: {{ or .From "none" }} &rarr; {{ or .To "none" }}{{ end }}</td></tr>
+{{ end }}</table>{{ end }}
+
+<h3>Seen in logs</h3>
+<table>
+<tr><th>Log</th><th>Index</th><th>Timestamp</th></tr>
+{{ range .Sightings }}<tr><td>{{ .Log }}</td><td>{{ .Index }}</td><td>{{ datetime .Timestamp }}</td></tr>
+{{ else }}<tr><td>{{ .Match.Log }}</td><td>{{ .Match.Index }}</td><td>{{ datetime .Match.Timestamp }}</td></tr>{{ end }}
+</table>
+
+<h3>Chain</h3>
+<table>
+<tr><th>Subject</th><th>Issuer</th><th>Not after</th><th>SHA256</th></tr>
+{{ range .Chain }}<tr><td>{{ .Subject }}</td><td>{{ .Issuer }}</td><td>{{ datetime .NotAfter }}</td><td>{{ .SHA256 }}</td></tr>
+{{ else }}<tr><td colspan="4" class="muted">No chain stored</td></tr>{{ end }}
+</table>
+
+<h3>PEM</h3>
+<pre>{{ .Cert.PEMCert }}{{ range .Chain }}{{ .PEM }}{{ end }}</pre>
+{{ template "footer" . }}{{ end }}
+`
+
+var templates = template.Must(template.New("").Funcs(template.FuncMap{
+	"join":     strings.Join,
+	"datetime": func(t time.Time) string { return t.UTC().Format("2006-01-02 15:04 MST") },
+	"duration": func(d time.Duration) string { return d.Truncate(time.Second).String() },
+}).Parse(layout))
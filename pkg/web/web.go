@@ -0,0 +1,305 @@
+package web
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/certificate-transparency/go/x509"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/api"
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+const Prefix = "/ui/"
+
+// LogStatus is scan progress and health of a log shown on the dashboard.
+type LogStatus struct {
+	Uri      string
+	Index    int64
+	TreeSize int64
+	Lag      int64
+	/* since the last successful STH fetch, not fetched yet if |Fetched| is not set */
+	STHAge   time.Duration
+	Fetched  bool
+	Problems []string
+}
+
+// Server serves triage dashboard over matches in |Store| under Prefix.
+type Server struct {
+	Store db.Store
+	/* password of HTTP basic auth, user name is recorded as analyst, read-only without it */
+	Token  string
+	Logs   func() []LogStatus
+	Triage api.TriageFunc
+	/* sends stored match to sinks again */
+	Escalate func(m *models.Match)
+
+	/* signs CSRF tokens of forms */
+	key []byte
+}
+
+func New(store db.Store, token string, logs func() []LogStatus, triage api.TriageFunc,
+	escalate func(m *models.Match)) *Server {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.Fatalf("Can't generate dashboard key (%v)", err)
+	}
+	return &Server{Store: store, Token: token, Logs: logs, Triage: triage, Escalate: escalate, key: key}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.user(r)
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Basic realm="ct_mon"`)
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'")
+	w.Header().Set("X-Frame-Options", "DENY")
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, Prefix), "/")
+	parts := strings.Split(path, "/")
+	switch {
+	case path == "" && (r.Method == "GET" || r.Method == "HEAD"):
+		s.index(w, r, user)
+	case len(parts) == 2 && parts[0] == "matches" && (r.Method == "GET" || r.Method == "HEAD"):
+		s.match(w, r, user, parts[1])
+	case len(parts) == 3 && parts[0] == "matches" && r.Method == "POST":
+		if s.Token == "" {
+			http.Error(w, "dashboard is read-only without api_token", http.StatusForbidden)
+			return
+		}
+		s.action(w, r, user, parts[1], parts[2])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+/* any user name is accepted, |Token| is the password, no user in read-only mode */
+func (s *Server) user(r *http.Request) (string, bool) {
+	if s.Token == "" {
+		return "", true
+	}
+	user, password, _ := r.BasicAuth()
+	if user == "" {
+		user = "anonymous"
+	}
+	return user, subtle.ConstantTimeCompare([]byte(password), []byte(s.Token)) == 1
+}
+
+func (s *Server) csrf(user string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(user))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Server) render(w http.ResponseWriter, name string, data interface{}) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		log.Printf("Error rendering dashboard %s (%v)", name, err)
+		http.Error(w, "template error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(buf.Bytes())
+}
+
+var statuses = []models.TriageStatus{models.TriageNew, models.TriageAcknowledged, models.TriageLegitimate,
+	models.TriageMalicious, models.TriageRevokeRequested}
+
+type indexData struct {
+	User     string
+	Statuses []models.TriageStatus
+	Logs     []LogStatus
+	Matches  []*api.Match
+	Query    url.Values
+	Error    string
+	Older    string
+	Newer    string
+}
+
+/* link to the same search with another |offset| */
+func page(q url.Values, offset int) string {
+	v := url.Values{}
+	for k, vs := range q {
+		v[k] = vs
+	}
+	v.Set("offset", strconv.Itoa(offset))
+	return Prefix + "?" + v.Encode()
+}
+
+func (s *Server) index(w http.ResponseWriter, r *http.Request, user string) {
+	data := &indexData{User: user, Statuses: statuses, Query: r.URL.Query()}
+	if s.Logs != nil {
+		data.Logs = s.Logs()
+	}
+	q, err := api.ParseQuery(data.Query)
+	if err != nil {
+		data.Error = err.Error()
+		s.render(w, "index", data)
+		return
+	}
+	stored, err := s.Store.FindCerts(q)
+	if err != nil {
+		log.Printf("Can't query matches (%v)", err)
+		data.Error = "storage error"
+	}
+	for i := range stored {
+		data.Matches = append(data.Matches, api.NewMatch(&stored[i]))
+	}
+	if len(stored) == q.Limit {
+		data.Older = page(data.Query, q.Offset+q.Limit)
+	}
+	if q.Offset > 0 {
+		newer := q.Offset - q.Limit
+		if newer < 0 {
+			newer = 0
+		}
+		data.Newer = page(data.Query, newer)
+	}
+	s.render(w, "index", data)
+}
+
+// ChainCert is decoded issuer certificate of a match.
+type ChainCert struct {
+	Subject  string
+	Issuer   string
+	NotAfter time.Time
+	SHA256   string
+	PEM      string
+}
+
+type matchData struct {
+	User     string
+	ReadOnly bool
+	CSRF     string
+	Done     string
+	Statuses []models.TriageStatus
+	Match    *api.Match
+	Cert     *db.CertInfo
+	/* decoded from stored PEM, nil if it doesn't parse */
+	Parsed    *x509.Certificate
+	Chain     []ChainCert
+	Sightings []db.Sighting
+}
+
+func name(cn string, org []string) string {
+	if len(org) > 0 {
+		return fmt.Sprintf("%s (%s)", cn, strings.Join(org, ", "))
+	}
+	return cn
+}
+
+func decodeChain(chain [][]byte) []ChainCert {
+	var result []ChainCert
+	for _, der := range chain {
+		sum := sha256.Sum256(der)
+		cc := ChainCert{SHA256: hex.EncodeToString(sum[:]),
+			PEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))}
+		if c, err := x509.ParseCertificate(der); err == nil {
+			cc.Subject = name(c.Subject.CommonName, c.Subject.Organization)
+			cc.Issuer = name(c.Issuer.CommonName, c.Issuer.Organization)
+			cc.NotAfter = c.NotAfter
+		}
+		result = append(result, cc)
+	}
+	return result
+}
+
+func (s *Server) find(w http.ResponseWriter, fingerprint string) *db.CertInfo {
+	c, err := s.Store.FindCert(fingerprint)
+	if err == db.ErrNotFound {
+		http.Error(w, "match not found", http.StatusNotFound)
+		return nil
+	}
+	if err != nil {
+		log.Printf("Can't load match %s (%v)", fingerprint, err)
+		http.Error(w, "storage error", http.StatusInternalServerError)
+		return nil
+	}
+	return c
+}
+
+func (s *Server) match(w http.ResponseWriter, r *http.Request, user string, fingerprint string) {
+	c := s.find(w, fingerprint)
+	if c == nil {
+		return
+	}
+	data := &matchData{User: user, ReadOnly: s.Token == "", CSRF: s.csrf(user), Done: r.URL.Query().Get("done"), Statuses: statuses,
+		Match: api.NewMatch(c), Cert: c, Chain: decodeChain(c.Chain)}
+	if block, _ := pem.Decode([]byte(c.PEMCert)); block != nil {
+		if parsed, err := x509.ParseCertificate(block.Bytes); err == nil {
+			data.Parsed = parsed
+		}
+	}
+	if si, err := s.Store.FindSightings(fingerprint); err == nil {
+		data.Sightings = si.Sightings
+	} else if err != db.ErrNotFound {
+		log.Printf("Can't load sightings of %s (%v)", fingerprint, err)
+	}
+	s.render(w, "match", data)
+}
+
+func (s *Server) action(w http.ResponseWriter, r *http.Request, user string, fingerprint string, action string) {
+	if subtle.ConstantTimeCompare([]byte(r.PostFormValue("csrf")), []byte(s.csrf(user))) != 1 {
+		http.Error(w, "invalid form, reload the page", http.StatusForbidden)
+		return
+	}
+	c := s.find(w, fingerprint)
+	if c == nil {
+		return
+	}
+
+	u := &db.TriageUpdate{By: user, Note: strings.TrimSpace(r.PostFormValue("note"))}
+	status := func(s models.TriageStatus) *models.TriageStatus { return &s }
+	var err error
+	switch action {
+	case "acknowledge":
+		u.Status = status(models.TriageAcknowledged)
+		_, err = s.Triage(fingerprint, u)
+	case "legitimate":
+		u.Status = status(models.TriageLegitimate)
+		_, err = s.Triage(fingerprint, u)
+	case "triage":
+		if v := r.PostFormValue("status"); v != "" {
+			st, perr := models.ParseTriageStatus(v)
+			if perr != nil {
+				http.Error(w, perr.Error(), http.StatusBadRequest)
+				return
+			}
+			u.Status = &st
+		}
+		if _, ok := r.PostForm["assignee"]; ok {
+			assignee := strings.TrimSpace(r.PostFormValue("assignee"))
+			u.Assignee = &assignee
+		}
+		_, err = s.Triage(fingerprint, u)
+	case "escalate":
+		m := api.NewMatch(c).Match
+		m.Escalated, m.Suppressed, m.Severity = true, false, models.SeverityCritical
+		s.Escalate(&m)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.Printf("Can't %s match %s (%v)", action, fingerprint, err)
+		http.Error(w, "storage error", http.StatusInternalServerError)
+		return
+	}
+	log.Printf("Match %s: %s by %s", fingerprint, action, user)
+	http.Redirect(w, r, Prefix+"matches/"+fingerprint+"?done="+action, http.StatusSeeOther)
+}
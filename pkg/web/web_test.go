@@ -0,0 +1,105 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/kyprizel/ct_mon/models"
+	"github.com/kyprizel/ct_mon/pkg/db"
+)
+
+func testServer(t *testing.T, token string) (*Server, *int, func()) {
+	store, err := db.InitSQL("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.StoreCertDetails(&db.CertInfo{Fingerprint: "ab12", CommonName: "login.example.com",
+		DNSNames: []string{"login.example.com"}}); err != nil {
+		t.Fatal(err)
+	}
+	triage := func(fingerprint string, u *db.TriageUpdate) (*db.CertInfo, error) {
+		c, _, err := store.UpdateTriage(fingerprint, u)
+		return c, err
+	}
+	escalated := new(int)
+	escalate := func(*models.Match) { *escalated++ }
+	return New(store, token, func() []LogStatus { return nil }, triage, escalate), escalated, func() { store.Close() }
+}
+
+func request(s *Server, method string, path string, user string, password string, form url.Values) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, strings.NewReader(form.Encode()))
+	if form != nil {
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if user != "" {
+		r.SetBasicAuth(user, password)
+	}
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	return w
+}
+
+func TestReadOnlyWithoutToken(t *testing.T) {
+	s, escalated, cleanup := testServer(t, "")
+	defer cleanup()
+
+	for _, path := range []string{"/ui/", "/ui/matches/ab12"} {
+		w := request(s, "GET", path, "", "", nil)
+		if w.Code != http.StatusOK {
+			t.Errorf("GET %s: %d", path, w.Code)
+		}
+		if strings.Contains(w.Body.String(), "<form method=\"POST\"") {
+			t.Errorf("GET %s: read-only page has forms", path)
+		}
+	}
+	for _, action := range []string{"acknowledge", "legitimate", "triage", "escalate"} {
+		form := url.Values{"csrf": {s.csrf("")}, "status": {"malicious"}}
+		if w := request(s, "POST", "/ui/matches/ab12/"+action, "analyst", "", form); w.Code != http.StatusForbidden {
+			t.Errorf("%s without token: %d, want %d", action, w.Code, http.StatusForbidden)
+		}
+	}
+	if *escalated != 0 {
+		t.Errorf("escalated %d times by read-only dashboard", *escalated)
+	}
+	c, err := s.Store.FindCert("ab12")
+	if err != nil || c.Status != models.TriageNew {
+		t.Errorf("status %q (%v) changed by read-only dashboard", c.Status, err)
+	}
+}
+
+func TestToken(t *testing.T) {
+	s, escalated, cleanup := testServer(t, "s3cret")
+	defer cleanup()
+
+	if w := request(s, "GET", "/ui/", "", "", nil); w.Code != http.StatusUnauthorized {
+		t.Errorf("GET without auth: %d", w.Code)
+	}
+	if w := request(s, "GET", "/ui/", "analyst", "wrong", nil); w.Code != http.StatusUnauthorized {
+		t.Errorf("GET with wrong token: %d", w.Code)
+	}
+	if w := request(s, "GET", "/ui/matches/ab12", "analyst", "s3cret", nil); w.Code != http.StatusOK ||
+		!strings.Contains(w.Body.String(), "/ui/matches/ab12/escalate") {
+		t.Errorf("GET with token: %d, forms missing", w.Code)
+	}
+
+	form := url.Values{"csrf": {s.csrf("other")}}
+	if w := request(s, "POST", "/ui/matches/ab12/legitimate", "analyst", "s3cret", form); w.Code != http.StatusForbidden {
+		t.Errorf("POST with CSRF token of other user: %d", w.Code)
+	}
+	form = url.Values{"csrf": {s.csrf("analyst")}}
+	for _, action := range []string{"legitimate", "escalate"} {
+		if w := request(s, "POST", "/ui/matches/ab12/"+action, "analyst", "s3cret", form); w.Code != http.StatusSeeOther {
+			t.Errorf("%s with token: %d, want %d", action, w.Code, http.StatusSeeOther)
+		}
+	}
+	if *escalated != 1 {
+		t.Errorf("escalated %d times, want 1", *escalated)
+	}
+	c, err := s.Store.FindCert("ab12")
+	if err != nil || c.Status != models.TriageLegitimate {
+		t.Errorf("status %q (%v), want %q", c.Status, err, models.TriageLegitimate)
+	}
+}
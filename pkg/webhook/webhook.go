@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+const (
+	PayloadVersion  = 1
+	SignatureHeader = "X-CT-Mon-Signature"
+	TimestampHeader = "X-CT-Mon-Timestamp"
+)
+
+/* delay before the first retry, doubled for every next one */
+var retryBase = time.Second
+
+// Payload is default JSON body, fields are only added within the same version.
+type Payload struct {
+	Version int `json:"version"`
+	/* match, log_health or triage */
+	Event     string              `json:"event"`
+	Match     *models.Match       `json:"match,omitempty"`
+	LogHealth *models.LogHealth   `json:"log_health,omitempty"`
+	Triage    *models.TriageEvent `json:"triage,omitempty"`
+}
+
+// CertHandler POSTs matches to |URL|.
+type CertHandler struct {
+	URL         string            `json:"url"`
+	Headers     map[string]string `json:"headers"`
+	Secret      string            `json:"secret"`
+	Timeout     int               `json:"timeout"`
+	Retries     int               `json:"retries"`
+	Template    string            `json:"body_template"`
+	ContentType string            `json:"content_type"`
+
+	tpl    *template.Template
+	client *http.Client
+}
+
+// New creates handler from |conf|, timeout is in seconds.
+func New(conf []byte) (*CertHandler, error) {
+	s := &CertHandler{Timeout: 10, Retries: 3, ContentType: "application/json"}
+	if err := json.Unmarshal(conf, s); err != nil {
+		return nil, err
+	}
+	if s.URL == "" {
+		return nil, errors.New("url is not set")
+	}
+	if s.Template != "" {
+		tpl, err := template.New("webhook").Parse(s.Template)
+		if err != nil {
+			return nil, err
+		}
+		s.tpl = tpl
+	}
+	s.client = &http.Client{Timeout: time.Duration(s.Timeout) * time.Second}
+	return s, nil
+}
+
+func (s *CertHandler) body(m *models.Match) ([]byte, error) {
+	if s.tpl == nil {
+		return json.Marshal(&Payload{Version: PayloadVersion, Event: "match", Match: m})
+	}
+	var buf bytes.Buffer
+	if err := s.tpl.Execute(&buf, m); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Sign returns signature of |body| sent at |timestamp| (unix seconds) with |secret|:
+// hex HMAC-SHA256 of "timestamp.body".
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *CertHandler) Notify(m *models.Match) error {
+	body, err := s.body(m)
+	if err != nil {
+		return err
+	}
+	return s.deliver(body, s.ContentType)
+}
+
+/* body_template is for matches, log health and triage are always sent as JSON payload */
+func (s *CertHandler) NotifyHealth(h *models.LogHealth) error {
+	body, err := json.Marshal(&Payload{Version: PayloadVersion, Event: "log_health", LogHealth: h})
+	if err != nil {
+		return err
+	}
+	return s.deliver(body, "application/json")
+}
+
+func (s *CertHandler) NotifyTriage(t *models.TriageEvent) error {
+	body, err := json.Marshal(&Payload{Version: PayloadVersion, Event: "triage", Triage: t})
+	if err != nil {
+		return err
+	}
+	return s.deliver(body, "application/json")
+}
+
+func (s *CertHandler) deliver(body []byte, contentType string) error {
+	var err error
+	var retry bool
+	for attempt := 0; ; attempt++ {
+		retry, err = s.post(body, contentType)
+		if err == nil || !retry || attempt >= s.Retries {
+			return err
+		}
+		time.Sleep(retryBase << uint(attempt))
+	}
+}
+
+/* returns true if request may succeed on retry */
+func (s *CertHandler) post(body []byte, contentType string) (bool, error) {
+	req, err := http.NewRequest("POST", s.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", "ct_mon")
+	for k, v := range s.Headers {
+		req.Header.Set(k, v)
+	}
+	if s.Secret != "" {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(TimestampHeader, ts)
+		req.Header.Set(SignatureHeader, Sign(s.Secret, ts, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode/100 == 2 {
+		return false, nil
+	}
+	err = fmt.Errorf("%s returned %s", s.URL, resp.Status)
+	return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests, err
+}
+
+func (s *CertHandler) Close() error {
+	return nil
+}
@@ -0,0 +1,212 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyprizel/ct_mon/models"
+)
+
+type request struct {
+	header http.Header
+	body   []byte
+	time   time.Time
+}
+
+/* records requests and answers them with |statuses| in turn, the last one repeats */
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []request
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	status := r.statuses[len(r.statuses)-1]
+	if n := len(r.requests); n < len(r.statuses) {
+		status = r.statuses[n]
+	}
+	r.requests = append(r.requests, request{header: req.Header, body: body, time: time.Now()})
+	w.WriteHeader(status)
+}
+
+func testHandler(t *testing.T, conf string, statuses ...int) (*CertHandler, *receiver, func()) {
+	retryBase = 10 * time.Millisecond
+	r := &receiver{statuses: statuses}
+	srv := httptest.NewServer(r)
+	s, err := New([]byte(fmt.Sprintf(conf, srv.URL)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, r, srv.Close
+}
+
+var match = &models.Match{Log: "https://ct.example.com/", Index: 42, Fingerprint: "ab12",
+	Rules: []string{"phishing"}, Severity: models.SeverityHigh, CommonName: "login.example.com",
+	DNSNames: []string{"login.example.com"}, Issuer: "Test CA", PEM: "-----BEGIN CERTIFICATE-----\n"}
+
+func TestPayload(t *testing.T) {
+	s, r, stop := testHandler(t, `{"url": "%s", "headers": {"Authorization": "Bearer x"}}`, 200)
+	defer stop()
+
+	if err := s.Notify(match); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.requests) != 1 {
+		t.Fatalf("%d requests, want 1", len(r.requests))
+	}
+	req := r.requests[0]
+	if ct := req.header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type %q", ct)
+	}
+	if auth := req.header.Get("Authorization"); auth != "Bearer x" {
+		t.Errorf("Authorization %q", auth)
+	}
+	if sig := req.header.Get(SignatureHeader); sig != "" {
+		t.Errorf("unsigned request has signature %q", sig)
+	}
+
+	var p struct {
+		Version int                        `json:"version"`
+		Event   string                     `json:"event"`
+		Match   map[string]json.RawMessage `json:"match"`
+	}
+	if err := json.Unmarshal(req.body, &p); err != nil {
+		t.Fatalf("invalid payload %s (%v)", req.body, err)
+	}
+	if p.Version != PayloadVersion || p.Event != "match" {
+		t.Errorf("version %d event %q", p.Version, p.Event)
+	}
+	want := map[string]string{
+		"cn":          `"login.example.com"`,
+		"dns_names":   `["login.example.com"]`,
+		"issuer":      `"Test CA"`,
+		"fingerprint": `"ab12"`,
+		"log":         `"https://ct.example.com/"`,
+		"index":       `42`,
+		"rules":       `["phishing"]`,
+		"severity":    `"high"`,
+		"pem":         `"-----BEGIN CERTIFICATE-----\n"`,
+	}
+	for k, v := range want {
+		if got := string(p.Match[k]); got != v {
+			t.Errorf("match.%s = %s, want %s", k, got, v)
+		}
+	}
+}
+
+func TestHealthAndTriagePayload(t *testing.T) {
+	s, r, stop := testHandler(t, `{"url": "%s", "body_template": "{{.CommonName}}", "content_type": "text/plain"}`, 204)
+	defer stop()
+
+	if err := s.NotifyHealth(&models.LogHealth{Log: "https://ct.example.com/", Problem: "stalled"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.NotifyTriage(&models.TriageEvent{Match: match, Status: models.TriageMalicious, By: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Notify(match); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, event := range []string{"log_health", "triage"} {
+		req := r.requests[i]
+		var p Payload
+		if err := json.Unmarshal(req.body, &p); err != nil || p.Event != event || p.Version != PayloadVersion {
+			t.Errorf("%s payload %s (%v)", event, req.body, err)
+		}
+		if ct := req.header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("%s Content-Type %q", event, ct)
+		}
+	}
+	if req := r.requests[2]; string(req.body) != "login.example.com" || req.header.Get("Content-Type") != "text/plain" {
+		t.Errorf("templated body %q Content-Type %q", req.body, req.header.Get("Content-Type"))
+	}
+}
+
+func TestSignature(t *testing.T) {
+	s, r, stop := testHandler(t, `{"url": "%s", "secret": "s3cret"}`, 200)
+	defer stop()
+
+	before := time.Now().Unix()
+	if err := s.Notify(match); err != nil {
+		t.Fatal(err)
+	}
+	req := r.requests[0]
+	ts := req.header.Get(TimestampHeader)
+	sent, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || sent < before || sent > time.Now().Unix() {
+		t.Errorf("timestamp %q", ts)
+	}
+	if sig := req.header.Get(SignatureHeader); sig != Sign("s3cret", ts, req.body) {
+		t.Errorf("signature %q", sig)
+	}
+	if Sign("other", ts, req.body) == Sign("s3cret", ts, req.body) {
+		t.Error("signature doesn't depend on secret")
+	}
+}
+
+func TestSign(t *testing.T) {
+	/* printf 1500000000.{} | openssl dgst -sha256 -hmac secret */
+	want := "sha256=fd82a5484b512271eb4df6eeed7adbb7d014939726d441430041f4d06f466b06"
+	if got := Sign("secret", "1500000000", []byte("{}")); got != want {
+		t.Errorf("Sign = %s, want %s", got, want)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		requests int
+		fail     bool
+	}{
+		{"success", []int{200}, 1, false},
+		{"5xx then success", []int{502, 503, 200}, 3, false},
+		{"too many requests", []int{429, 200}, 2, false},
+		{"5xx until retries end", []int{500}, 4, true},
+		{"4xx is not retried", []int{400}, 1, true},
+	}
+	for _, tt := range tests {
+		s, r, stop := testHandler(t, `{"url": "%s", "retries": 3}`, tt.statuses...)
+		err := s.Notify(match)
+		stop()
+		if (err != nil) != tt.fail {
+			t.Errorf("%s: error %v", tt.name, err)
+		}
+		if len(r.requests) != tt.requests {
+			t.Errorf("%s: %d requests, want %d", tt.name, len(r.requests), tt.requests)
+		}
+		/* backoff doubles */
+		for i := 1; i < len(r.requests); i++ {
+			if d := r.requests[i].time.Sub(r.requests[i-1].time); d < retryBase<<uint(i-1) {
+				t.Errorf("%s: retry %d after %v", tt.name, i, d)
+			}
+		}
+	}
+}
+
+func TestRetryNetworkError(t *testing.T) {
+	s, r, stop := testHandler(t, `{"url": "%s", "retries": 2}`, 200)
+	stop()
+	start := time.Now()
+	if err := s.Notify(match); err == nil {
+		t.Error("no error from closed server")
+	}
+	/* network errors are retried too */
+	if d := time.Since(start); d < 3*retryBase {
+		t.Errorf("gave up after %v, retries take at least %v", d, 3*retryBase)
+	}
+	if len(r.requests) != 0 {
+		t.Errorf("%d requests to closed server", len(r.requests))
+	}
+}
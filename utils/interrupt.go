@@ -5,10 +5,11 @@ package utils
 import (
 	"os"
 	"os/signal"
+	"syscall"
 )
 
 func NotifyInterrupt() <-chan os.Signal {
 	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	return c
 }